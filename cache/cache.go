@@ -37,6 +37,13 @@ func (ims InMemoryStorage) Set(key string, data interface{}, cacheTime time.Dura
 	ims.lock.Lock()
 	defer ims.lock.Unlock()
 
+	// Drop expired entries, otherwise keys which are never read again are kept forever
+	for existingKey, entry := range ims.entries {
+		if entry.IsExpired() {
+			delete(ims.entries, existingKey)
+		}
+	}
+
 	ims.entries[key] = CacheEntry{
 		Data:   data,
 		Expiry: time.Now().Add(cacheTime).Unix(),
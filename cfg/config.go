@@ -23,12 +23,28 @@ package cfg
 // SOFTWARE.
 
 import (
+	"fmt"
 	"gopkg.in/yaml.v2"
 	"io/ioutil"
+	"net/url"
 )
 
 var ReleaseTag = "development"
 
+type WikiConfig struct {
+	Name      string `yaml:"name"`
+	ApiUrl    string `yaml:"api_url"`
+	IndexUrl  string `yaml:"index_url"`
+	StatsPage string `yaml:"stats_page"`
+	OAuth     struct {
+		InitiateUrl  string `yaml:"initiate_url"`
+		AuthorizeUrl string `yaml:"authorize_url"`
+		TokenUrl     string `yaml:"token_url"`
+		IdentifyUrl  string `yaml:"identify_url"`
+		Issuer       string `yaml:"issuer"`
+	} `yaml:"oauth"`
+}
+
 type Config struct {
 	Runtime struct {
 		Release string
@@ -48,13 +64,193 @@ type Config struct {
 		Secret string
 	}
 	App struct {
-		UpdateStats bool `yaml:"update_stats"`
-		AdminOnly   bool `yaml:"admin_only"`
+		UpdateStats       bool `yaml:"update_stats"`
+		AdminOnly         bool `yaml:"admin_only"`
+		StatsLeaderboards bool `yaml:"stats_leaderboards"`
 	}
 	Wikipedia struct {
 		Username string `yaml:"username"`
 		Password   string `yaml:"password"`
 	}
+	Wikis         []WikiConfig `yaml:"wikis"`
+	Qualification struct {
+		Enabled   bool    `yaml:"enabled"`
+		EditGroup int     `yaml:"edit_group"`
+		Edits     int     `yaml:"edits"`
+		Threshold float32 `yaml:"threshold"`
+	}
+	Training     TrainingConfig     `yaml:"training"`
+	ReportImport ReportImportConfig `yaml:"report_import"`
+	Webhooks     WebhookConfig      `yaml:"webhooks"`
+	Jobs         struct {
+		Enabled   *bool             `yaml:"enabled"`
+		Schedules map[string]string `yaml:"schedules"`
+	} `yaml:"jobs"`
+}
+
+// TrainingConfig controls downloading training data for reviewed edits
+type TrainingConfig struct {
+	ApiUrl            string  `yaml:"api_url"`
+	Workers           int     `yaml:"workers"`
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             int     `yaml:"burst"`
+	Timeout           int     `yaml:"timeout"`
+	Retries           int     `yaml:"retries"`
+	MaxBackoff        int     `yaml:"max_backoff"`
+	SchemaVersion     int     `yaml:"schema_version"`
+	RefreshAfterDays  int     `yaml:"refresh_after_days"`
+}
+
+// ReportImportConfig controls importing the edits marked for review in the report interface
+type ReportImportConfig struct {
+	Url            string `yaml:"url"`
+	EditGroup      string `yaml:"edit_group"`
+	Required       int    `yaml:"required"`
+	Classification string `yaml:"classification"`
+}
+
+// WebhookConfig controls delivering review events to the subscribed webhooks
+type WebhookConfig struct {
+	Timeout    int `yaml:"timeout"`
+	Attempts   int `yaml:"attempts"`
+	MaxBackoff int `yaml:"max_backoff"`
+}
+
+// DefaultWiki is the first configured wiki, used when a session has not selected one
+func (c *Config) DefaultWiki() *WikiConfig {
+	return &c.Wikis[0]
+}
+
+func (c *Config) LookupWiki(name string) *WikiConfig {
+	for i := range c.Wikis {
+		if c.Wikis[i].Name == name {
+			return &c.Wikis[i]
+		}
+	}
+	return nil
+}
+
+func applyWikiDefaults(config *Config) error {
+	if len(config.Wikis) == 0 {
+		config.Wikis = []WikiConfig{{
+			Name:      "enwiki",
+			ApiUrl:    "https://en.wikipedia.org/w/api.php",
+			IndexUrl:  "https://en.wikipedia.org/w/index.php",
+			StatsPage: "User:ClueBot NG/ReviewInterface/Stats",
+		}}
+	}
+
+	for i := range config.Wikis {
+		wiki := &config.Wikis[i]
+		if wiki.Name == "" || wiki.IndexUrl == "" || wiki.ApiUrl == "" {
+			return fmt.Errorf("wiki %d requires a name, api_url and index_url", i)
+		}
+
+		// Everything else can be derived from the index url for a standard MediaWiki install
+		if wiki.OAuth.InitiateUrl == "" {
+			wiki.OAuth.InitiateUrl = wiki.IndexUrl + "?title=Special:OAuth/initiate"
+		}
+		if wiki.OAuth.AuthorizeUrl == "" {
+			wiki.OAuth.AuthorizeUrl = wiki.IndexUrl + "?title=Special:OAuth/authorize"
+		}
+		if wiki.OAuth.TokenUrl == "" {
+			wiki.OAuth.TokenUrl = wiki.IndexUrl + "?title=Special:OAuth/token"
+		}
+		if wiki.OAuth.IdentifyUrl == "" {
+			wiki.OAuth.IdentifyUrl = wiki.IndexUrl + "?title=Special:OAuth/identify"
+		}
+		if wiki.OAuth.Issuer == "" {
+			indexUrl, err := url.Parse(wiki.IndexUrl)
+			if err != nil {
+				return err
+			}
+			wiki.OAuth.Issuer = fmt.Sprintf("%s://%s", indexUrl.Scheme, indexUrl.Host)
+		}
+	}
+	return nil
+}
+
+func applyTrainingDefaults(config *Config) {
+	if config.Training.ApiUrl == "" {
+		config.Training.ApiUrl = "https://cluebotng-api.toolforge.org/"
+	}
+	if config.Training.Workers <= 0 {
+		config.Training.Workers = 4
+	}
+	if config.Training.RequestsPerSecond <= 0 {
+		config.Training.RequestsPerSecond = 5
+	}
+	if config.Training.Burst <= 0 {
+		config.Training.Burst = config.Training.Workers
+	}
+	if config.Training.Timeout <= 0 {
+		config.Training.Timeout = 30
+	}
+	if config.Training.Retries < 0 {
+		config.Training.Retries = 0
+	}
+	if config.Training.MaxBackoff <= 0 {
+		config.Training.MaxBackoff = 86400
+	}
+	if config.Training.SchemaVersion <= 0 {
+		config.Training.SchemaVersion = 1
+	}
+}
+
+func applyReportImportDefaults(config *Config) error {
+	if config.ReportImport.Url == "" {
+		config.ReportImport.Url = "https://cluebotng.toolforge.org/api/?action=review.export"
+	}
+	if config.ReportImport.EditGroup == "" {
+		config.ReportImport.EditGroup = "Report Interface Import"
+	}
+	if config.ReportImport.Required <= 0 {
+		config.ReportImport.Required = 2
+	}
+	if config.ReportImport.Classification == "" {
+		config.ReportImport.Classification = "constructive"
+	}
+
+	switch config.ReportImport.Classification {
+	case "vandalism", "constructive", "skipped":
+		return nil
+	}
+	return fmt.Errorf("report_import classification must be vandalism, constructive or skipped")
+}
+
+func applyWebhookDefaults(config *Config) {
+	if config.Webhooks.Timeout <= 0 {
+		config.Webhooks.Timeout = 10
+	}
+	if config.Webhooks.Attempts <= 0 {
+		config.Webhooks.Attempts = 6
+	}
+	if config.Webhooks.MaxBackoff <= 0 {
+		config.Webhooks.MaxBackoff = 3600
+	}
+}
+
+// JobsEnabled reports if the scheduler should run, jobs are enabled unless explicitly disabled
+func (c *Config) JobsEnabled() bool {
+	return c.Jobs.Enabled == nil || *c.Jobs.Enabled
+}
+
+func applyJobDefaults(config *Config) {
+	// Matches the schedules previously run from the Toolforge jobs
+	defaults := map[string]string{
+		"stats":           "13 9 * * *",
+		"report-import":   "13 * * * *",
+		"training-import": "30 * * * *",
+		"webhook-deliver": "* * * * *",
+	}
+	if config.Jobs.Schedules == nil {
+		config.Jobs.Schedules = map[string]string{}
+	}
+	for name, schedule := range defaults {
+		if _, ok := config.Jobs.Schedules[name]; !ok {
+			config.Jobs.Schedules[name] = schedule
+		}
+	}
 }
 
 func LoadConfigFromDisk(configPath string) (*Config, error) {
@@ -68,6 +264,16 @@ func LoadConfigFromDisk(configPath string) (*Config, error) {
 		return nil, err
 	}
 
+	if err := applyWikiDefaults(&config); err != nil {
+		return nil, err
+	}
+	applyTrainingDefaults(&config)
+	if err := applyReportImportDefaults(&config); err != nil {
+		return nil, err
+	}
+	applyWebhookDefaults(&config)
+	applyJobDefaults(&config)
+
 	config.Runtime.Release = ReleaseTag
 	return &config, nil
 }
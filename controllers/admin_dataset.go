@@ -0,0 +1,71 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"github.com/cluebotng/reviewng/db"
+	"html/template"
+	"net/http"
+)
+
+func (app *App) AdminDatasetDiffHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	snapshots, err := app.dbh.FetchAllDatasetSnapshots()
+	if err != nil {
+		panic(err)
+	}
+
+	// Only show the picker until a snapshot is chosen
+	var diff *datasetDiff
+	if r.URL.Query().Get("from") != "" {
+		if diff = app.loadDatasetDiff(w, r); diff == nil {
+			return
+		}
+	}
+
+	t, err := template.ParseFS(app.fsTemplates, "templates/admin/dataset_diff.tmpl")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := t.Execute(w, struct {
+		Snapshots []*db.DatasetSnapshot
+		From      string
+		To        string
+		Diff      *datasetDiff
+	}{snapshots, r.URL.Query().Get("from"), r.URL.Query().Get("to"), diff}); err != nil {
+		panic(err)
+	}
+}
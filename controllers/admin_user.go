@@ -24,8 +24,10 @@ package controllers
 
 import (
 	"github.com/cluebotng/reviewng/db"
+	"github.com/gorilla/mux"
 	"html/template"
 	"net/http"
+	"strconv"
 )
 
 func (app *App) AdminUsersHandler(w http.ResponseWriter, r *http.Request) {
@@ -47,12 +49,121 @@ func (app *App) AdminUsersHandler(w http.ResponseWriter, r *http.Request) {
 		panic(err)
 	}
 
+	qualifications, err := app.dbh.FetchAllUserQualifications()
+	if err != nil {
+		panic(err)
+	}
+
 	t, err := template.ParseFS(app.fsTemplates, "templates/admin/users.tmpl")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := t.Execute(w, struct{ Users []*db.User }{allUsers}); err != nil {
+	if err := t.Execute(w, struct {
+		Users          []*db.User
+		Qualifications map[int]*db.UserQualification
+	}{allUsers, qualifications}); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) AdminUserApproveHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	// Decode the request
+	approveUserId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+	approveUser, err := app.dbh.LookupUserById(approveUserId)
+	if err != nil {
+		panic(err)
+	}
+	if approveUser == nil {
+		http.Error(w, "User Not Found", 404)
+		return
+	}
+
+	if err := app.dbh.UpdateUser(approveUser.Id, !approveUser.Approved, approveUser.Admin); err != nil {
+		panic(err)
+	}
+	http.Redirect(w, r, "/admin/users", http.StatusFound)
+}
+
+func (app *App) AdminUserSuspendHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	// Decode the request
+	suspendUserId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+	suspendUser, err := app.dbh.LookupUserById(suspendUserId)
+	if err != nil {
+		panic(err)
+	}
+	if suspendUser == nil {
+		http.Error(w, "User Not Found", 404)
+		return
+	}
+
+	// Confirmed from the preview page
+	if r.Method == "POST" {
+		var changes []*db.EditLabelChange
+		if suspendUser.Suspended {
+			changes, err = app.dbh.UnsuspendUser(suspendUser.Id)
+		} else {
+			changes, err = app.dbh.SuspendUser(suspendUser.Id)
+		}
+		if err != nil {
+			panic(err)
+		}
+		app.queueSuspensionWebhookEvents(changes)
+		http.Redirect(w, r, "/admin/users", http.StatusFound)
+		return
+	}
+
+	preview, err := app.dbh.CalculateUserSuspensionImpact(suspendUser)
+	if err != nil {
+		panic(err)
+	}
+
+	t, err := template.New("suspend.tmpl").Funcs(template.FuncMap{
+		"classificationToHuman": ConvertClassificationToHumanString,
+	}).ParseFS(app.fsTemplates, "templates/admin/suspend.tmpl")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := t.Execute(w, struct {
+		User    *db.User
+		Preview *db.SuspensionPreview
+	}{
+		User:    suspendUser,
+		Preview: preview,
+	}); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,237 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"github.com/cluebotng/reviewng/webhooks"
+	"github.com/gorilla/mux"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func (app *App) AdminWebhooksHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	allWebhooks, err := app.dbh.FetchAllWebhooks()
+	if err != nil {
+		panic(err)
+	}
+
+	deliveries, err := app.dbh.LookupRecentWebhookDeliveries(100)
+	if err != nil {
+		panic(err)
+	}
+
+	t, err := template.ParseFS(app.fsTemplates, "templates/admin/webhooks.tmpl")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := t.Execute(w, struct {
+		Webhooks   []*db.Webhook
+		Deliveries []*db.WebhookDelivery
+		Events     []string
+	}{allWebhooks, deliveries, webhooks.Events}); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) AdminWebhookCreateHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	webhookUrl, err := url.Parse(r.PostForm.Get("url"))
+	if err != nil || (webhookUrl.Scheme != "http" && webhookUrl.Scheme != "https") || webhookUrl.Host == "" {
+		http.Error(w, fmt.Sprintf("invalid url: %s", r.PostForm.Get("url")), 400)
+		return
+	}
+
+	events := []string{}
+	for _, event := range webhooks.Events {
+		for _, selected := range r.PostForm["events"] {
+			if selected == event {
+				events = append(events, event)
+			}
+		}
+	}
+	if len(events) == 0 {
+		http.Error(w, "no events selected", 400)
+		return
+	}
+
+	// Receivers need the secret to verify deliveries, so one is generated when not given
+	secret := r.PostForm.Get("secret")
+	if secret == "" {
+		if secret, err = generateWebhookSecret(); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := app.dbh.CreateWebhook(webhookUrl.String(), secret, events); err != nil {
+		panic(err)
+	}
+
+	log.Printf("Webhook for %s created by %s", webhookUrl.String(), user.Username)
+	http.Redirect(w, r, "/admin/webhooks", http.StatusFound)
+}
+
+func (app *App) AdminWebhookDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	webhookId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+
+	deleted, err := app.dbh.DeleteWebhook(webhookId)
+	if err != nil {
+		panic(err)
+	}
+	if !deleted {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	log.Printf("Webhook %d deleted by %s", webhookId, user.Username)
+	http.Redirect(w, r, "/admin/webhooks", http.StatusFound)
+}
+
+func (app *App) AdminWebhookTestHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	webhookId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+
+	webhook, err := app.dbh.LookupWebhookById(webhookId)
+	if err != nil {
+		panic(err)
+	}
+	if webhook == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	payload, err := newWebhookPayload(webhooks.EventPing, map[string]interface{}{"webhook_id": webhook.Id, "user": user.Username})
+	if err != nil {
+		panic(err)
+	}
+
+	if err := app.dbh.CreateWebhookDelivery(webhook.Id, webhooks.EventPing, payload); err != nil {
+		panic(err)
+	}
+	app.scheduler.Trigger(jobWebhookDeliver)
+
+	http.Redirect(w, r, "/admin/webhooks", http.StatusFound)
+}
+
+func (app *App) AdminWebhookRedeliverHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	deliveryId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+
+	if _, err := app.dbh.RedeliverWebhookDelivery(deliveryId); err != nil {
+		panic(err)
+	}
+	app.scheduler.Trigger(jobWebhookDeliver)
+
+	http.Redirect(w, r, "/admin/webhooks", http.StatusFound)
+}
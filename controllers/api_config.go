@@ -28,10 +28,16 @@ import (
 )
 
 func (app *App) ApiConfigHandler(w http.ResponseWriter, r *http.Request) {
+	wiki := app.getSessionWiki(r)
 	response, err := json.Marshal(map[string]interface{}{
 		"release":      app.config.Runtime.Release,
 		"update_stats": app.config.App.UpdateStats,
 		"admin_only":   app.config.App.AdminOnly,
+		"wiki": map[string]string{
+			"name":      wiki.Name,
+			"api_url":   wiki.ApiUrl,
+			"index_url": wiki.IndexUrl,
+		},
 	})
 	if err != nil {
 		panic(err)
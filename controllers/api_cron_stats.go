@@ -77,10 +77,10 @@ func calculateUserContributionStats(app *App) []userContributionStat {
 	return stats
 }
 
-func calculateEditGroupStats(app *App) []editGroupStat {
+func calculateEditGroupStats(app *App, wiki string) []editGroupStat {
 	stats := []editGroupStat{}
 
-	allEditGroups, err := app.dbh.FetchAllEditGroups()
+	allEditGroups, err := app.dbh.FetchEditGroupsByWiki(wiki)
 	if err != nil {
 		panic(err)
 	}
@@ -118,36 +118,50 @@ func calculateEditGroupStats(app *App) []editGroupStat {
 	return stats
 }
 
-func (app *App) ApiCronStatsHandler(w http.ResponseWriter, r *http.Request) {
+// publishStats renders the stats page for each wiki, updating it on wiki when enabled
+func (app *App) publishStats() error {
 	t, err := template.ParseFS(app.fsTemplates, "templates/stats.tmpl")
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	var tpl bytes.Buffer
-	if err := t.Execute(&tpl, struct {
-		EditGroups []editGroupStat
-		AllUsers   []userContributionStat
-	}{
-		EditGroups: calculateEditGroupStats(app),
-		AllUsers:   calculateUserContributionStats(app),
-	}); err != nil {
-		panic(err)
+	allUsers := calculateUserContributionStats(app)
+	var boards *leaderboards
+	if app.config.App.StatsLeaderboards {
+		globalBoards := calculateLeaderboards(app, 0)
+		boards = &globalBoards
 	}
 
-	if app.config.App.UpdateStats {
-		if app.config.Wikipedia.Username != "" {
-			if err := wikipedia.UpdatePageWithCredentials(tpl.String(), app.config.Wikipedia.Username, app.config.Wikipedia.Password); err != nil {
-				panic(err)
-			}
-		} else {
-			if err := wikipedia.UpdatePage(""); err != nil {
-				panic(err)
+	// Each wiki gets a page covering the edit groups scoped to it
+	for _, wiki := range app.config.Wikis {
+		var tpl bytes.Buffer
+		if err := t.Execute(&tpl, struct {
+			EditGroups   []editGroupStat
+			AllUsers     []userContributionStat
+			Leaderboards *leaderboards
+		}{
+			EditGroups:   calculateEditGroupStats(app, wiki.Name),
+			AllUsers:     allUsers,
+			Leaderboards: boards,
+		}); err != nil {
+			return err
+		}
+
+		if app.config.App.UpdateStats && wiki.StatsPage != "" {
+			if app.config.Wikipedia.Username != "" {
+				if err := wikipedia.UpdatePageWithCredentials(wiki.ApiUrl, wiki.StatsPage, tpl.String(), app.config.Wikipedia.Username, app.config.Wikipedia.Password); err != nil {
+					return err
+				}
+			} else {
+				if err := wikipedia.UpdatePage(wiki.ApiUrl, wiki.StatsPage, tpl.String()); err != nil {
+					return err
+				}
 			}
 		}
 	}
+	return nil
+}
 
-	if _, err := w.Write(tpl.Bytes()); err != nil {
-		panic(err)
-	}
+func (app *App) ApiCronStatsHandler(w http.ResponseWriter, r *http.Request) {
+	app.writeJobTriggered(w, jobStats)
 }
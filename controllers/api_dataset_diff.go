@@ -0,0 +1,293 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+const (
+	datasetChangeAdded      = "added"
+	datasetChangeRemoved    = "removed"
+	datasetChangeRelabelled = "relabelled"
+)
+
+type datasetVotes struct {
+	Vandalism    int `json:"vandalism"`
+	Constructive int `json:"constructive"`
+	Skipped      int `json:"skipped"`
+}
+
+type datasetDiffChange struct {
+	EditGroupId       int           `json:"edit_group_id"`
+	EditId            int           `json:"edit_id"`
+	Change            string        `json:"change"`
+	OldClassification string        `json:"old_classification,omitempty"`
+	NewClassification string        `json:"new_classification,omitempty"`
+	OldVotes          *datasetVotes `json:"old_votes,omitempty"`
+	NewVotes          *datasetVotes `json:"new_votes,omitempty"`
+}
+
+type datasetDiffGroup struct {
+	EditGroupId int    `json:"edit_group_id"`
+	Name        string `json:"name"`
+	Added       int    `json:"added"`
+	Removed     int    `json:"removed"`
+	Relabelled  int    `json:"relabelled"`
+	Unchanged   int    `json:"unchanged"`
+}
+
+type datasetDiff struct {
+	From    string               `json:"from"`
+	To      string               `json:"to"`
+	Groups  []*datasetDiffGroup  `json:"groups"`
+	Changes []*datasetDiffChange `json:"changes"`
+}
+
+type datasetEntryKey struct {
+	EditGroupId int
+	EditId      int
+}
+
+func newDatasetVotes(entry *db.DatasetSnapshotEntry) *datasetVotes {
+	return &datasetVotes{Vandalism: entry.Vandalism, Constructive: entry.Constructive, Skipped: entry.Skipped}
+}
+
+// calculateDatasetDiff compares two sets of entries, keyed by group and edit
+func calculateDatasetDiff(oldEntries, newEntries []*db.DatasetSnapshotEntry, editGroupNames map[int]string) ([]*datasetDiffGroup, []*datasetDiffChange) {
+	oldByKey := map[datasetEntryKey]*db.DatasetSnapshotEntry{}
+	for _, entry := range oldEntries {
+		oldByKey[datasetEntryKey{entry.EditGroupId, entry.EditId}] = entry
+	}
+	newByKey := map[datasetEntryKey]*db.DatasetSnapshotEntry{}
+	for _, entry := range newEntries {
+		newByKey[datasetEntryKey{entry.EditGroupId, entry.EditId}] = entry
+	}
+
+	groups := map[int]*datasetDiffGroup{}
+	group := func(editGroupId int) *datasetDiffGroup {
+		if _, ok := groups[editGroupId]; !ok {
+			groups[editGroupId] = &datasetDiffGroup{EditGroupId: editGroupId, Name: editGroupNames[editGroupId]}
+		}
+		return groups[editGroupId]
+	}
+
+	changes := []*datasetDiffChange{}
+	for key, oldEntry := range oldByKey {
+		newEntry, ok := newByKey[key]
+		if !ok {
+			group(key.EditGroupId).Removed++
+			changes = append(changes, &datasetDiffChange{
+				EditGroupId:       key.EditGroupId,
+				EditId:            key.EditId,
+				Change:            datasetChangeRemoved,
+				OldClassification: ConvertClassificationToString(oldEntry.Classification),
+				OldVotes:          newDatasetVotes(oldEntry),
+			})
+			continue
+		}
+
+		if oldEntry.Classification == newEntry.Classification {
+			group(key.EditGroupId).Unchanged++
+			continue
+		}
+
+		group(key.EditGroupId).Relabelled++
+		changes = append(changes, &datasetDiffChange{
+			EditGroupId:       key.EditGroupId,
+			EditId:            key.EditId,
+			Change:            datasetChangeRelabelled,
+			OldClassification: ConvertClassificationToString(oldEntry.Classification),
+			NewClassification: ConvertClassificationToString(newEntry.Classification),
+			OldVotes:          newDatasetVotes(oldEntry),
+			NewVotes:          newDatasetVotes(newEntry),
+		})
+	}
+
+	for key, newEntry := range newByKey {
+		if _, ok := oldByKey[key]; ok {
+			continue
+		}
+		group(key.EditGroupId).Added++
+		changes = append(changes, &datasetDiffChange{
+			EditGroupId:       key.EditGroupId,
+			EditId:            key.EditId,
+			Change:            datasetChangeAdded,
+			NewClassification: ConvertClassificationToString(newEntry.Classification),
+			NewVotes:          newDatasetVotes(newEntry),
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].EditGroupId == changes[j].EditGroupId {
+			return changes[i].EditId < changes[j].EditId
+		}
+		return changes[i].EditGroupId < changes[j].EditGroupId
+	})
+
+	sortedGroups := []*datasetDiffGroup{}
+	for _, g := range groups {
+		sortedGroups = append(sortedGroups, g)
+	}
+	sort.Slice(sortedGroups, func(i, j int) bool {
+		return sortedGroups[i].EditGroupId < sortedGroups[j].EditGroupId
+	})
+	return sortedGroups, changes
+}
+
+// loadDatasetDiffSide returns the entries of a snapshot, or the live labels for "live"
+func loadDatasetDiffSide(app *App, value string, liveEditGroupIds []int) (string, []*db.DatasetSnapshotEntry, error) {
+	if value == "live" {
+		entries, err := app.dbh.CalculateLiveDatasetEntries(liveEditGroupIds)
+		return "live", entries, err
+	}
+
+	snapshotId, err := strconv.Atoi(value)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid snapshot: %s", value)
+	}
+	snapshot, err := app.dbh.LookupDatasetSnapshotById(snapshotId)
+	if err != nil {
+		return "", nil, err
+	}
+	if snapshot == nil {
+		return "", nil, fmt.Errorf("unknown snapshot: %d", snapshotId)
+	}
+
+	entries, err := app.dbh.LookupDatasetSnapshotEntries(snapshot.Id)
+	return snapshot.Name, entries, err
+}
+
+// loadDatasetDiff compares the `from` snapshot with the `to` snapshot, defaulting to the live labels
+func (app *App) loadDatasetDiff(w http.ResponseWriter, r *http.Request) *datasetDiff {
+	from, to := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+	if to == "" {
+		to = "live"
+	}
+	if from == "" || from == "live" {
+		http.Error(w, "from must be a snapshot", 400)
+		return nil
+	}
+
+	allEditGroups, err := app.dbh.FetchAllEditGroups()
+	if err != nil {
+		panic(err)
+	}
+	editGroupNames := map[int]string{}
+	for _, editGroup := range allEditGroups {
+		editGroupNames[editGroup.Id] = editGroup.Name
+	}
+
+	fromName, fromEntries, err := loadDatasetDiffSide(app, from, nil)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return nil
+	}
+
+	// The live labels cover the groups in the snapshot, or every group for an empty snapshot
+	liveEditGroupIds := []int{}
+	seenEditGroupIds := map[int]bool{}
+	for _, entry := range fromEntries {
+		if _, ok := seenEditGroupIds[entry.EditGroupId]; !ok {
+			seenEditGroupIds[entry.EditGroupId] = true
+			liveEditGroupIds = append(liveEditGroupIds, entry.EditGroupId)
+		}
+	}
+	if len(liveEditGroupIds) == 0 {
+		for _, editGroup := range allEditGroups {
+			liveEditGroupIds = append(liveEditGroupIds, editGroup.Id)
+		}
+	}
+
+	toName, toEntries, err := loadDatasetDiffSide(app, to, liveEditGroupIds)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return nil
+	}
+
+	groups, changes := calculateDatasetDiff(fromEntries, toEntries, editGroupNames)
+	return &datasetDiff{From: fromName, To: toName, Groups: groups, Changes: changes}
+}
+
+func writeDatasetDiffCsv(w io.Writer, diff *datasetDiff) error {
+	votes := func(v *datasetVotes) []string {
+		if v == nil {
+			return []string{"", "", ""}
+		}
+		return []string{strconv.Itoa(v.Vandalism), strconv.Itoa(v.Constructive), strconv.Itoa(v.Skipped)}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{
+		"edit_group_id", "edit_id", "change", "old_classification", "new_classification",
+		"old_vandalism", "old_constructive", "old_skipped",
+		"new_vandalism", "new_constructive", "new_skipped",
+	}); err != nil {
+		return err
+	}
+	for _, change := range diff.Changes {
+		row := []string{strconv.Itoa(change.EditGroupId), strconv.Itoa(change.EditId), change.Change, change.OldClassification, change.NewClassification}
+		row = append(row, votes(change.OldVotes)...)
+		row = append(row, votes(change.NewVotes)...)
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (app *App) ApiDatasetDiffHandler(w http.ResponseWriter, r *http.Request) {
+	diff := app.loadDatasetDiff(w, r)
+	if diff == nil {
+		return
+	}
+
+	response, err := json.Marshal(diff)
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) ApiDatasetDiffCsvHandler(w http.ResponseWriter, r *http.Request) {
+	diff := app.loadDatasetDiff(w, r)
+	if diff == nil {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=UTF-8")
+	if err := writeDatasetDiffCsv(w, diff); err != nil {
+		panic(err)
+	}
+}
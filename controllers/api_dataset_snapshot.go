@@ -0,0 +1,373 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"github.com/gorilla/mux"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type datasetSnapshotFile struct {
+	ContentType string
+	Write       func(w io.Writer, entries []*db.DatasetSnapshotEntry) error
+}
+
+// datasetSnapshotFiles are the downloads of a snapshot, each is listed in the manifest once published
+var datasetSnapshotFiles = map[string]datasetSnapshotFile{
+	"entries.csv":  {"text/csv; charset=UTF-8", writeDatasetSnapshotCsv},
+	"trainer.json": {"application/json; charset=UTF-8", writeDatasetSnapshotTrainerJson},
+}
+
+func writeDatasetSnapshotCsv(w io.Writer, entries []*db.DatasetSnapshotEntry) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"edit_group_id", "edit_id", "classification", "confidence", "vandalism", "constructive", "skipped"}); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := writer.Write([]string{
+			strconv.Itoa(entry.EditGroupId),
+			strconv.Itoa(entry.EditId),
+			ConvertClassificationToString(entry.Classification),
+			strconv.FormatFloat(float64(entry.Confidence), 'f', 4, 32),
+			strconv.Itoa(entry.Vandalism),
+			strconv.Itoa(entry.Constructive),
+			strconv.Itoa(entry.Skipped),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeDatasetSnapshotTrainerJson writes the snapshot in the same layout as /api/export/trainer.json
+func writeDatasetSnapshotTrainerJson(w io.Writer, entries []*db.DatasetSnapshotEntry) error {
+	data := TrainedData{}
+	for _, entry := range entries {
+		if _, ok := data[entry.EditGroupId]; !ok {
+			data[entry.EditGroupId] = map[int]bool{}
+		}
+		data[entry.EditGroupId][entry.EditId] = entry.Classification == db.EDIT_CLASSIFICATION_VANDALISM
+	}
+	return json.NewEncoder(w).Encode(data)
+}
+
+func calculateDatasetSnapshotFiles(entries []*db.DatasetSnapshotEntry) ([]*db.DatasetSnapshotFile, error) {
+	files := []*db.DatasetSnapshotFile{}
+	for name, file := range datasetSnapshotFiles {
+		var content bytes.Buffer
+		if err := file.Write(&content, entries); err != nil {
+			return nil, err
+		}
+		files = append(files, &db.DatasetSnapshotFile{
+			Name:    name,
+			Sha256:  calculateSha256(content.Bytes()),
+			Size:    int64(content.Len()),
+			Content: content.Bytes(),
+		})
+	}
+	return files, nil
+}
+
+func calculateSha256(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+func (app *App) lookupDatasetSnapshot(w http.ResponseWriter, r *http.Request) *db.DatasetSnapshot {
+	snapshotId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+
+	snapshot, err := app.dbh.LookupDatasetSnapshotById(snapshotId)
+	if err != nil {
+		panic(err)
+	}
+	if snapshot == nil {
+		http.Error(w, "Not Found", 404)
+		return nil
+	}
+	return snapshot
+}
+
+func (app *App) ApiDatasetSnapshotListHandler(w http.ResponseWriter, r *http.Request) {
+	snapshots, err := app.dbh.FetchAllDatasetSnapshots()
+	if err != nil {
+		panic(err)
+	}
+
+	response, err := json.Marshal(snapshots)
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) ApiDatasetSnapshotCreateHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	newSnapshot := struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		EditGroups  []int  `json:"edit_groups"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&newSnapshot); err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+	if newSnapshot.Name == "" {
+		http.Error(w, "name is required", 400)
+		return
+	}
+
+	allSnapshots, err := app.dbh.FetchAllDatasetSnapshots()
+	if err != nil {
+		panic(err)
+	}
+	for _, snapshot := range allSnapshots {
+		if snapshot.Name == newSnapshot.Name {
+			http.Error(w, fmt.Sprintf("snapshot already exists: %s", newSnapshot.Name), 409)
+			return
+		}
+	}
+
+	// Default to every edit group
+	if len(newSnapshot.EditGroups) == 0 {
+		allEditGroups, err := app.dbh.FetchAllEditGroups()
+		if err != nil {
+			panic(err)
+		}
+		for _, editGroup := range allEditGroups {
+			newSnapshot.EditGroups = append(newSnapshot.EditGroups, editGroup.Id)
+		}
+	}
+
+	snapshot, err := app.dbh.CreateDatasetSnapshot(newSnapshot.Name, newSnapshot.Description, newSnapshot.EditGroups)
+	if err != nil {
+		panic(err)
+	}
+
+	response, err := json.Marshal(snapshot)
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) ApiDatasetSnapshotGetHandler(w http.ResponseWriter, r *http.Request) {
+	snapshot := app.lookupDatasetSnapshot(w, r)
+	if snapshot == nil {
+		return
+	}
+
+	files, err := app.dbh.LookupDatasetSnapshotFiles(snapshot.Id)
+	if err != nil {
+		panic(err)
+	}
+
+	response, err := json.Marshal(struct {
+		*db.DatasetSnapshot
+		Files []*db.DatasetSnapshotFile `json:"files"`
+	}{snapshot, files})
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) ApiDatasetSnapshotPublishHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	snapshot := app.lookupDatasetSnapshot(w, r)
+	if snapshot == nil {
+		return
+	}
+
+	entries, err := app.dbh.LookupDatasetSnapshotEntries(snapshot.Id)
+	if err != nil {
+		panic(err)
+	}
+
+	files, err := calculateDatasetSnapshotFiles(entries)
+	if err != nil {
+		panic(err)
+	}
+
+	published, err := app.dbh.PublishDatasetSnapshot(snapshot.Id, files)
+	if err != nil {
+		panic(err)
+	}
+	if !published {
+		http.Error(w, "Snapshot is already published", 409)
+		return
+	}
+	app.ApiDatasetSnapshotGetHandler(w, r)
+}
+
+func (app *App) ApiDatasetSnapshotDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	snapshot := app.lookupDatasetSnapshot(w, r)
+	if snapshot == nil {
+		return
+	}
+
+	// Published snapshots are immutable
+	deleted, err := app.dbh.DeleteDatasetSnapshot(snapshot.Id)
+	if err != nil {
+		panic(err)
+	}
+	if !deleted {
+		http.Error(w, "Snapshot is published", 409)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *App) ApiDatasetSnapshotManifestHandler(w http.ResponseWriter, r *http.Request) {
+	snapshot := app.lookupDatasetSnapshot(w, r)
+	if snapshot == nil {
+		return
+	}
+
+	// Drafts can still be replaced, so have no manifest
+	if snapshot.Status != db.DATASET_SNAPSHOT_PUBLISHED {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	files, err := app.dbh.LookupDatasetSnapshotFiles(snapshot.Id)
+	if err != nil {
+		panic(err)
+	}
+
+	// Same layout as sha256sum, so downloads can be verified with `sha256sum -c`
+	w.Header().Set("Content-Type", "text/plain; charset=UTF-8")
+	for _, file := range files {
+		if _, err := fmt.Fprintf(w, "%s  %s\n", file.Sha256, file.Name); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (app *App) ApiDatasetSnapshotFileHandler(w http.ResponseWriter, r *http.Request) {
+	file, ok := datasetSnapshotFiles[mux.Vars(r)["file"]]
+	if !ok {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	snapshot := app.lookupDatasetSnapshot(w, r)
+	if snapshot == nil {
+		return
+	}
+
+	w.Header().Set("X-Dataset-Snapshot", strconv.Itoa(snapshot.Id))
+
+	// Drafts are rendered from the entries, they have no manifest and may still be deleted
+	if snapshot.Status != db.DATASET_SNAPSHOT_PUBLISHED {
+		entries, err := app.dbh.LookupDatasetSnapshotEntries(snapshot.Id)
+		if err != nil {
+			panic(err)
+		}
+
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("X-Dataset-Snapshot-Status", "draft")
+		if err := file.Write(w, entries); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	// Published files are served as stored, checked against the manifest
+	published, err := app.dbh.LookupDatasetSnapshotFile(snapshot.Id, mux.Vars(r)["file"])
+	if err != nil {
+		panic(err)
+	}
+	if published == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	if calculateSha256(published.Content) != published.Sha256 {
+		http.Error(w, fmt.Sprintf("Stored %s does not match the manifest", published.Name), 500)
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("X-Dataset-Snapshot-Status", "published")
+	if _, err := w.Write(published.Content); err != nil {
+		panic(err)
+	}
+}
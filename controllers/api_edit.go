@@ -96,13 +96,13 @@ func (app *App) ApiEditNextHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Not approved, return an error
-	if !user.Approved || (app.config.App.AdminOnly && !user.Admin) {
+	if !user.Approved || user.Suspended || (app.config.App.AdminOnly && !user.Admin) {
 		http.Error(w, "Forbidden", 403)
 		return
 	}
 
 	// Get an edit
-	edit, err := app.dbh.CalculateRandomPendingEditForUser(user)
+	edit, err := app.dbh.CalculateRandomPendingEditForUser(user, app.getSessionWiki(r).Name)
 	if err != nil {
 		panic(err)
 	}
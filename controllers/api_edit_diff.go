@@ -0,0 +1,147 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"github.com/cluebotng/reviewng/diff"
+	"github.com/gorilla/mux"
+	"html/template"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Unchanged lines shown either side of each change
+const diffContextLines = 3
+
+// Diffs rendered from fetched training data are kept briefly, covering a reviewer switching render options
+const diffCacheTime = 10 * time.Minute
+
+func unixTime(timestamp int) *time.Time {
+	if timestamp == 0 {
+		return nil
+	}
+	t := time.Unix(int64(timestamp), 0).UTC()
+	return &t
+}
+
+func (app *App) ApiEditDiffHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Suspended, return an error
+	if user.Suspended {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	// Decode the request
+	editId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+
+	// Only edits under review are rendered, rather than any revision id
+	edit, err := app.dbh.LookupEditById(editId)
+	if err != nil {
+		panic(err)
+	}
+	if edit == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	trainingData, err := app.dbh.GetTrainingDataByEditId(editId)
+	if err != nil {
+		panic(err)
+	}
+
+	// Only reviewed edits have stored training data, others are fetched when viewed and the rendered diff cached
+	cacheKey := fmt.Sprintf("diff-%d", editId)
+	fetched := trainingData == nil
+	if fetched {
+		if cachedData := app.cacheStore.Get(cacheKey); cachedData != nil {
+			if _, err := w.Write(cachedData.([]byte)); err != nil {
+				panic(err)
+			}
+			return
+		}
+
+		if trainingData, err = app.trainingDownloader.Fetch(editId); err != nil {
+			log.Printf("Failed to fetch training data for diff: %v: %+v", editId, err)
+			http.Error(w, fmt.Sprintf("No training data is stored for edit %d, and it could not be fetched: %v", editId, err), 404)
+			return
+		}
+	}
+
+	rendered, err := app.renderEditDiff(editId, trainingData)
+	if err != nil {
+		panic(err)
+	}
+	if fetched {
+		app.cacheStore.Set(cacheKey, rendered, diffCacheTime)
+	}
+
+	if _, err := w.Write(rendered); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) renderEditDiff(editId int, trainingData *db.TrainingData) ([]byte, error) {
+	chunks := diff.Words(trainingData.Previous.Text, trainingData.Current.Text)
+
+	t, err := template.ParseFS(app.fsTemplates, "templates/diff.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	var rendered bytes.Buffer
+	if err := t.Execute(&rendered, struct {
+		EditId           int
+		TrainingData     *db.TrainingData
+		Timestamp        *time.Time
+		PreviousTime     *time.Time
+		RegistrationTime *time.Time
+		PageCreationTime *time.Time
+		Diff             template.HTML
+	}{
+		EditId:           editId,
+		TrainingData:     trainingData,
+		Timestamp:        unixTime(trainingData.Current.Timestamp),
+		PreviousTime:     unixTime(trainingData.Previous.Timestamp),
+		RegistrationTime: unixTime(trainingData.Current.User.RegistrationTime),
+		PageCreationTime: unixTime(trainingData.Page.CreationTime),
+		Diff:             template.HTML(diff.HTML(chunks, diffContextLines)),
+	}); err != nil {
+		return nil, err
+	}
+	return rendered.Bytes(), nil
+}
@@ -0,0 +1,377 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	splitGroupByEdit = "edit"
+	splitGroupByPage = "page"
+	splitGroupByUser = "user"
+)
+
+type splitRatio struct {
+	Name  string  `json:"name"`
+	Ratio float64 `json:"ratio"`
+}
+
+// splitDefinition describes how labelled edits from the source groups are divided into new groups
+type splitDefinition struct {
+	Name             string       `json:"name"`
+	SourceEditGroups []int        `json:"source_edit_groups"`
+	Seed             string       `json:"seed"`
+	GroupBy          string       `json:"group_by"`
+	Splits           []splitRatio `json:"splits"`
+	All              bool         `json:"all"`
+	Weight           int          `json:"weight"`
+}
+
+type splitResult struct {
+	Name         string `json:"name"`
+	EditGroupId  int    `json:"edit_group_id"`
+	Edits        int    `json:"edits"`
+	Vandalism    int    `json:"vandalism"`
+	Constructive int    `json:"constructive"`
+}
+
+// splitUnit is the set of edits which must end up in the same split
+type splitUnit struct {
+	Key            string
+	Hash           string
+	Classification int
+	Edits          []*db.Edit
+}
+
+func (d *splitDefinition) validate() error {
+	if strings.TrimSpace(d.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(d.SourceEditGroups) == 0 {
+		return fmt.Errorf("source_edit_groups is required")
+	}
+
+	if d.GroupBy == "" {
+		d.GroupBy = splitGroupByEdit
+	}
+	if d.GroupBy != splitGroupByEdit && d.GroupBy != splitGroupByPage && d.GroupBy != splitGroupByUser {
+		return fmt.Errorf("invalid group_by: %s", d.GroupBy)
+	}
+
+	// Matches the layout of the legacy datasets
+	if len(d.Splits) == 0 {
+		d.Splits = []splitRatio{{"train", 0.6}, {"trial", 0.2}, {"bayestrain", 0.2}}
+	}
+
+	seen := map[string]bool{}
+	for _, split := range d.Splits {
+		if split.Name == "" || split.Name == "all" {
+			return fmt.Errorf("invalid split name: %s", split.Name)
+		}
+		if _, ok := seen[split.Name]; ok {
+			return fmt.Errorf("duplicate split name: %s", split.Name)
+		}
+		seen[split.Name] = true
+
+		if split.Ratio <= 0 {
+			return fmt.Errorf("invalid ratio for %s", split.Name)
+		}
+	}
+	return nil
+}
+
+func (d *splitDefinition) editGroupName(split string) string {
+	return fmt.Sprintf("%s %s", d.Name, split)
+}
+
+func splitUnitKey(groupBy string, edit *db.Edit, trainingData *db.TrainingData) string {
+	// Edits without training data can't be grouped, so stand alone
+	if trainingData != nil {
+		switch groupBy {
+		case splitGroupByPage:
+			return fmt.Sprintf("page:%s:%s", trainingData.Page.Namespace, trainingData.Page.Title)
+		case splitGroupByUser:
+			return fmt.Sprintf("user:%s", trainingData.Current.User.Name)
+		}
+	}
+	return fmt.Sprintf("edit:%d", edit.Id)
+}
+
+func hashSplitUnit(seed, key string) string {
+	hash := sha256.Sum256([]byte(seed + "\x00" + key))
+	return hex.EncodeToString(hash[:])
+}
+
+// calculateSplitUnits collects the labelled edits of the source groups into units, keyed by group_by
+func calculateSplitUnits(app *App, definition *splitDefinition) ([]*splitUnit, error) {
+	units := map[string]*splitUnit{}
+	seenEditIds := map[int]bool{}
+	for _, editGroupId := range definition.SourceEditGroups {
+		trainingData := map[int]*db.TrainingData{}
+		if definition.GroupBy != splitGroupByEdit {
+			cursor, err := app.dbh.StreamTrainingDataByGroupId(editGroupId)
+			if err != nil {
+				return nil, err
+			}
+			for {
+				editId, td, err := cursor.Next()
+				if err != nil {
+					cursor.Close()
+					return nil, err
+				}
+				if td == nil {
+					break
+				}
+				trainingData[editId] = td
+			}
+			cursor.Close()
+		}
+
+		edits, err := app.dbh.LookupEditsByGroupId(editGroupId)
+		if err != nil {
+			return nil, err
+		}
+		for _, edit := range edits {
+			if _, ok := seenEditIds[edit.Id]; ok {
+				continue
+			}
+			seenEditIds[edit.Id] = true
+
+			// Only vandalism and constructive labels can be stratified
+			classification := edit.ReviewedClassification()
+			if classification != db.EDIT_CLASSIFICATION_VANDALISM && classification != db.EDIT_CLASSIFICATION_CONSTRUCTIVE {
+				continue
+			}
+
+			key := splitUnitKey(definition.GroupBy, edit, trainingData[edit.Id])
+			if _, ok := units[key]; !ok {
+				units[key] = &splitUnit{Key: key, Hash: hashSplitUnit(definition.Seed, key)}
+			}
+			units[key].Edits = append(units[key].Edits, edit)
+		}
+	}
+
+	allUnits := []*splitUnit{}
+	for _, unit := range units {
+		// A unit is stratified by the label of the majority of its edits, ties count as vandalism
+		vandalism := 0
+		for _, edit := range unit.Edits {
+			if edit.ReviewedClassification() == db.EDIT_CLASSIFICATION_VANDALISM {
+				vandalism++
+			}
+		}
+		unit.Classification = db.EDIT_CLASSIFICATION_CONSTRUCTIVE
+		if vandalism*2 >= len(unit.Edits) {
+			unit.Classification = db.EDIT_CLASSIFICATION_VANDALISM
+		}
+
+		sort.Slice(unit.Edits, func(i, j int) bool {
+			return unit.Edits[i].Id < unit.Edits[j].Id
+		})
+		allUnits = append(allUnits, unit)
+	}
+
+	// Ordering only depends on the seed and keys, so re-runs produce the same split
+	sort.Slice(allUnits, func(i, j int) bool {
+		if allUnits[i].Hash == allUnits[j].Hash {
+			return allUnits[i].Key < allUnits[j].Key
+		}
+		return allUnits[i].Hash < allUnits[j].Hash
+	})
+	return allUnits, nil
+}
+
+// assignSplitUnits distributes the units of each label across the splits in proportion to the ratios
+func assignSplitUnits(units []*splitUnit, splits []splitRatio) [][]*splitUnit {
+	totalRatio := 0.0
+	for _, split := range splits {
+		totalRatio += split.Ratio
+	}
+
+	assigned := make([][]*splitUnit, len(splits))
+	for _, classification := range []int{db.EDIT_CLASSIFICATION_VANDALISM, db.EDIT_CLASSIFICATION_CONSTRUCTIVE} {
+		totalEdits := 0
+		for _, unit := range units {
+			if unit.Classification == classification {
+				totalEdits += len(unit.Edits)
+			}
+		}
+
+		seenEdits := 0
+		for _, unit := range units {
+			if unit.Classification != classification {
+				continue
+			}
+
+			// Place the unit by the position of its midpoint within the label
+			position := (float64(seenEdits) + float64(len(unit.Edits))/2) / float64(totalEdits) * totalRatio
+			seenEdits += len(unit.Edits)
+
+			index, cumulativeRatio := len(splits)-1, 0.0
+			for i, split := range splits {
+				cumulativeRatio += split.Ratio
+				if position < cumulativeRatio {
+					index = i
+					break
+				}
+			}
+			assigned[index] = append(assigned[index], unit)
+		}
+	}
+	return assigned
+}
+
+// newSplitMembers collects the edits of the units into the members of the split group, along with the result
+func newSplitMembers(definition *splitDefinition, name string, units []*splitUnit) (*db.EditGroupSplitMembers, *splitResult) {
+	result := &splitResult{Name: name}
+	editIds := []int{}
+	for _, unit := range units {
+		for _, edit := range unit.Edits {
+			editIds = append(editIds, edit.Id)
+			if edit.ReviewedClassification() == db.EDIT_CLASSIFICATION_VANDALISM {
+				result.Vandalism++
+			} else {
+				result.Constructive++
+			}
+		}
+	}
+	sort.Ints(editIds)
+	result.Edits = len(editIds)
+	return &db.EditGroupSplitMembers{Split: name, EditGroupName: definition.editGroupName(name), EditIds: editIds}, result
+}
+
+// checkSplitTarget refuses to replace a group which wasn't created by this split, including the source groups
+func checkSplitTarget(app *App, definition *splitDefinition, members *db.EditGroupSplitMembers) error {
+	editGroup, err := app.dbh.LookupEditGroupByName(members.EditGroupName)
+	if err != nil {
+		return err
+	}
+	if editGroup == nil {
+		return nil
+	}
+
+	for _, editGroupId := range definition.SourceEditGroups {
+		if editGroupId == editGroup.Id {
+			return fmt.Errorf("edit group %s is a source of the split", members.EditGroupName)
+		}
+	}
+
+	owner, err := app.dbh.LookupEditGroupSplit(editGroup.Id)
+	if err != nil {
+		return err
+	}
+	if owner == nil || owner.Name != definition.Name || owner.Split != members.Split {
+		return fmt.Errorf("edit group %s already exists and was not created by split %s", members.EditGroupName, definition.Name)
+	}
+	return nil
+}
+
+func (app *App) ApiEditGroupSplitHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	definition := splitDefinition{}
+	if err := json.NewDecoder(r.Body).Decode(&definition); err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+	if err := definition.validate(); err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	// The new groups are reviewed on the same wiki as the source groups
+	wiki := ""
+	for _, editGroupId := range definition.SourceEditGroups {
+		editGroup, err := app.dbh.LookupEditGroupById(editGroupId)
+		if err != nil {
+			panic(err)
+		}
+		if editGroup == nil {
+			http.Error(w, fmt.Sprintf("unknown edit group: %d", editGroupId), 400)
+			return
+		}
+		if wiki != "" && editGroup.Wiki != wiki {
+			http.Error(w, "source edit groups must be on the same wiki", 400)
+			return
+		}
+		wiki = editGroup.Wiki
+	}
+
+	units, err := calculateSplitUnits(app, &definition)
+	if err != nil {
+		panic(err)
+	}
+
+	results, allMembers := []*splitResult{}, []*db.EditGroupSplitMembers{}
+	for i, splitUnits := range assignSplitUnits(units, definition.Splits) {
+		members, result := newSplitMembers(&definition, definition.Splits[i].Name, splitUnits)
+		allMembers, results = append(allMembers, members), append(results, result)
+	}
+	if definition.All {
+		members, result := newSplitMembers(&definition, "all", units)
+		allMembers, results = append(allMembers, members), append(results, result)
+	}
+
+	for _, members := range allMembers {
+		if err := checkSplitTarget(app, &definition, members); err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+	}
+
+	editGroupIds, err := app.dbh.MaterialiseEditGroupSplit(definition.Name, wiki, definition.Weight, allMembers)
+	if err != nil {
+		panic(err)
+	}
+	for _, result := range results {
+		result.EditGroupId = editGroupIds[result.Name]
+	}
+
+	response, err := json.Marshal(results)
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
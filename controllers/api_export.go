@@ -25,7 +25,9 @@ package controllers
 import (
 	"encoding/json"
 	"encoding/xml"
+	"fmt"
 	"github.com/cluebotng/reviewng/db"
+	"io"
 	"net/http"
 	"time"
 )
@@ -66,116 +68,344 @@ type Data struct {
 
 type TrainedData map[int]map[int]bool
 
-func calculateDataDump(app *App, done bool) Data {
-	cacheKey := "api-data-dump"
-	if done {
-		cacheKey += "-done"
-	}
+// TrainedDataDelta is the trainer data for a since cursor, edits which lost their label are set to "U" rather than a bool
+type TrainedDataDelta map[int]map[int]interface{}
 
-	if cachedData := app.cacheStore.Get(cacheKey); cachedData != nil {
-		return cachedData.(Data)
-	}
+const (
+	exportSectionEdits = iota
+	exportSectionReviewed
+	exportSectionDone
+)
 
-	// Fetch user data
-	userData := []User{}
+var exportSectionNames = map[int]string{
+	exportSectionEdits:    "Edits",
+	exportSectionReviewed: "Reviewed",
+	exportSectionDone:     "Done",
+}
+
+// dumpSource provides the contents of a Data dump one edit group at a time
+type dumpSource interface {
+	EditGroups() ([]*db.EditGroup, error)
+	Users() ([]User, error)
+	ForEachEdit(editGroup *db.EditGroup, section int, fn func(Edit) error) error
+}
+
+type dbDumpSource struct {
+	app          *App
+	done         bool
+	filter       *exportFilter
+	userNameById map[int]string
+}
+
+func newDbDumpSource(app *App, done bool, filter *exportFilter) (*dbDumpSource, error) {
 	allUsers, err := app.dbh.FetchAllUsers()
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	userNameById := map[int]string{}
 	for _, user := range allUsers {
 		userNameById[user.Id] = user.Username
-		if !done {
-			userClassifications, err := app.dbh.CalculateTotalUserClassifications(user)
-			if err != nil {
-				panic(err)
-			}
-			userData = append(userData, User{
-				Key:             user.Id,
-				Nick:            user.Username,
-				Classifications: userClassifications,
-			})
-		}
 	}
+	return &dbDumpSource{app: app, done: done, filter: filter, userNameById: userNameById}, nil
+}
 
-	// Fetch edit group data
-	editGroups := []EditGroup{}
-	allEditGroups, err := app.dbh.FetchAllEditGroups()
+func (s *dbDumpSource) EditGroups() ([]*db.EditGroup, error) {
+	editGroups, err := s.app.dbh.FetchAllEditGroups()
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	for _, editGroup := range allEditGroups {
-		editGroupEdits, err := app.dbh.LookupEditsByGroupId(editGroup.Id)
+	return s.filter.FilterEditGroups(editGroups), nil
+}
+
+func (s *dbDumpSource) Users() ([]User, error) {
+	allUsers, err := s.app.dbh.FetchAllUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	userTotals, err := s.app.dbh.CalculateAllUserClassificationTotals()
+	if err != nil {
+		return nil, err
+	}
+
+	userData := []User{}
+	for _, user := range allUsers {
+		userData = append(userData, User{
+			Key:             user.Id,
+			Nick:            user.Username,
+			Classifications: userTotals[user.Id],
+		})
+	}
+	return userData, nil
+}
+
+func includeEditInSection(e *db.Edit, section int) bool {
+	switch section {
+	case exportSectionReviewed:
+		return e.UserClassificationsConstructive+e.UserClassificationsSkipped+e.UserClassificationsVandalism > 0
+	case exportSectionDone:
+		return e.ReviewedClassification() != db.EDIT_CLASSIFICATION_UNKNOWN
+	}
+	return true
+}
+
+func (s *dbDumpSource) ForEachEdit(editGroup *db.EditGroup, section int, fn func(Edit) error) error {
+	edits, err := s.app.dbh.StreamEditsByGroupId(editGroup.Id)
+	if err != nil {
+		return err
+	}
+	defer edits.Close()
+
+	// Classifications are ordered by edit id, so they can be consumed alongside the edits
+	var classifications *db.UserClassificationCursor
+	var pendingClassification *db.UserClassification
+	if !s.done {
+		if classifications, err = s.app.dbh.StreamUserClassificationsByGroupId(editGroup.Id); err != nil {
+			return err
+		}
+		defer classifications.Close()
+		if pendingClassification, err = classifications.Next(); err != nil {
+			return err
+		}
+	}
+
+	for {
+		e, err := edits.Next()
 		if err != nil {
-			panic(err)
+			return err
+		}
+		if e == nil {
+			return nil
 		}
 
-		allEdits, reviewedEdits, doneEdits := []Edit{}, []Edit{}, []Edit{}
-		for _, e := range editGroupEdits {
-			allComments, allUsers := []string{}, []string{}
-			if !done {
-				userEditClassification, err := app.dbh.LookupUserClassificationsByEditId(e.Id)
-				if err != nil {
-					panic(err)
+		allComments, allUsers := []string{}, []string{}
+		for pendingClassification != nil && pendingClassification.EditId <= e.Id {
+			if pendingClassification.EditId == e.Id {
+				if pendingClassification.Comment != "" {
+					allComments = append(allComments, pendingClassification.Comment)
 				}
-				for _, uec := range userEditClassification {
-					if uec.Comment != "" {
-						allComments = append(allComments, uec.Comment)
-					}
-					if val, ok := userNameById[uec.UserId]; ok {
-						allUsers = append(allUsers, val)
-					}
+				if val, ok := s.userNameById[pendingClassification.UserId]; ok {
+					allUsers = append(allUsers, val)
 				}
 			}
+			if pendingClassification, err = classifications.Next(); err != nil {
+				return err
+			}
+		}
+
+		// Label removals are sent as done with a U classification, as the done section otherwise only has labelled edits
+		included := includeEditInSection(e, section) && s.filter.IncludesEdit(e)
+		if !included && !(section == exportSectionDone && s.filter.IncludesLabelRemoval(e)) {
+			continue
+		}
+
+		if err := fn(Edit{
+			Key:                    e.Id,
+			Id:                     e.Id,
+			Weight:                 editGroup.Weight,
+			Required:               e.Required,
+			Constructive:           e.UserClassificationsConstructive,
+			Skipped:                e.UserClassificationsSkipped,
+			Vandalism:              e.UserClassificationsVandalism,
+			OriginalClassification: ConvertClassificationToString(e.Classification),
+			RealClassification:     ConvertClassificationToString(e.ReviewedClassification()),
+			Comments:               allComments,
+			Users:                  allUsers,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func exportSections(done bool) []int {
+	if done {
+		return []int{exportSectionDone}
+	}
+	return []int{exportSectionEdits, exportSectionReviewed, exportSectionDone}
+}
+
+func flushWriter(w io.Writer) {
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// writeXmlDump streams the same document as encoding a Data struct, one edit group at a time
+func writeXmlDump(w io.Writer, source dumpSource, done bool) error {
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "\t")
+
+	startElement := func(name string) xml.StartElement {
+		return xml.StartElement{Name: xml.Name{Local: name}}
+	}
+
+	editGroups, err := source.EditGroups()
+	if err != nil {
+		return err
+	}
+
+	// Wrapping elements are always written by the encoder, even for empty slices
+	if err := encoder.EncodeToken(startElement("Data")); err != nil {
+		return err
+	}
+	if err := encoder.EncodeToken(startElement("EditGroups")); err != nil {
+		return err
+	}
+	for _, editGroup := range editGroups {
+		if err := encoder.EncodeToken(startElement("EditGroup")); err != nil {
+			return err
+		}
+		if err := encoder.EncodeElement(editGroup.Id, startElement("Key")); err != nil {
+			return err
+		}
+		if err := encoder.EncodeElement(editGroup.Name, startElement("Name")); err != nil {
+			return err
+		}
+		if err := encoder.EncodeElement(editGroup.Weight, startElement("Weight")); err != nil {
+			return err
+		}
 
-			edit := Edit{
-				Key:                    e.Id,
-				Id:                     e.Id,
-				Weight:                 editGroup.Weight,
-				Required:               e.Required,
-				Constructive:           e.UserClassificationsConstructive,
-				Skipped:                e.UserClassificationsSkipped,
-				Vandalism:              e.UserClassificationsVandalism,
-				OriginalClassification: ConvertClassificationToString(e.Classification),
-				RealClassification:     ConvertClassificationToString(e.ReviewedClassification()),
-				Comments:               allComments,
-				Users:                  allUsers,
+		for _, section := range []int{exportSectionEdits, exportSectionReviewed, exportSectionDone} {
+			if err := encoder.EncodeToken(startElement(exportSectionNames[section])); err != nil {
+				return err
 			}
-			allEdits = append(allEdits, edit)
-			if e.UserClassificationsConstructive+e.UserClassificationsSkipped+e.UserClassificationsVandalism > 0 {
-				reviewedEdits = append(reviewedEdits, edit)
+			if !done || section == exportSectionDone {
+				if err := source.ForEachEdit(editGroup, section, func(edit Edit) error {
+					return encoder.EncodeElement(edit, startElement("Edit"))
+				}); err != nil {
+					return err
+				}
 			}
-			if e.ReviewedClassification() != db.EDIT_CLASSIFICATION_UNKNOWN {
-				doneEdits = append(doneEdits, edit)
+			if err := encoder.EncodeToken(startElement(exportSectionNames[section]).End()); err != nil {
+				return err
 			}
 		}
 
-		eg := EditGroup{
-			Key:    editGroup.Id,
-			Name:   editGroup.Name,
-			Weight: editGroup.Weight,
-			Done:   doneEdits,
+		if err := encoder.EncodeToken(startElement("EditGroup").End()); err != nil {
+			return err
 		}
-		if !done {
-			eg.Edits = allEdits
-			eg.Reviewed = reviewedEdits
+		if err := encoder.Flush(); err != nil {
+			return err
 		}
-		editGroups = append(editGroups, eg)
+		flushWriter(w)
+	}
+	if err := encoder.EncodeToken(startElement("EditGroups").End()); err != nil {
+		return err
 	}
 
-	data := Data{EditGroups: editGroups}
+	users := []User{}
 	if !done {
-		data.Users = userData
+		if users, err = source.Users(); err != nil {
+			return err
+		}
+	}
+	if err := encoder.EncodeElement(struct {
+		Users []User `xml:"User"`
+	}{users}, startElement("Users")); err != nil {
+		return err
 	}
 
-	app.cacheStore.Set(cacheKey, data, time.Hour)
-	return data
+	if err := encoder.EncodeToken(startElement("Data").End()); err != nil {
+		return err
+	}
+	return encoder.Flush()
+}
+
+// writeJsonDump streams the same document as encoding a Data struct, one edit group at a time
+func writeJsonDump(w io.Writer, source dumpSource, done bool) error {
+	editGroups, err := source.EditGroups()
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, `{"EditGroups":[`); err != nil {
+		return err
+	}
+	for i, editGroup := range editGroups {
+		name, err := json.Marshal(editGroup.Name)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, `{"Key":%d,"Name":%s,"Weight":%d`, editGroup.Id, name, editGroup.Weight); err != nil {
+			return err
+		}
+
+		// Sections not included in the dump are nil on the struct
+		if done {
+			if _, err := io.WriteString(w, `,"Edits":null,"Reviewed":null`); err != nil {
+				return err
+			}
+		}
+		for _, section := range exportSections(done) {
+			if _, err := fmt.Fprintf(w, `,"%s":[`, exportSectionNames[section]); err != nil {
+				return err
+			}
+			first := true
+			if err := source.ForEachEdit(editGroup, section, func(edit Edit) error {
+				if !first {
+					if _, err := io.WriteString(w, ","); err != nil {
+						return err
+					}
+				}
+				first = false
+				encoded, err := json.Marshal(edit)
+				if err != nil {
+					return err
+				}
+				_, err = w.Write(encoded)
+				return err
+			}); err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, "]"); err != nil {
+				return err
+			}
+		}
+
+		if _, err := io.WriteString(w, "}"); err != nil {
+			return err
+		}
+		flushWriter(w)
+	}
+
+	users := []User(nil)
+	if !done {
+		if users, err = source.Users(); err != nil {
+			return err
+		}
+	}
+	encodedUsers, err := json.Marshal(users)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "],\"Users\":%s}\n", encodedUsers); err != nil {
+		return err
+	}
+	return nil
 }
 
-func calculateTrainingDump(app *App) TrainedData {
-	if cachedData := app.cacheStore.Get("api-training-dump"); cachedData != nil {
-		return cachedData.(TrainedData)
+func calculateTrainingDump(app *App, filter *exportFilter) TrainedData {
+	// Keyed by the data version, so the cache never outlives the ETag it is served with
+	version, err := app.dbh.LookupDataVersion()
+	if err != nil {
+		panic(err)
+	}
+	cacheKey := fmt.Sprintf("api-training-dump-%d", version.Version)
+
+	// Only the full dump is cached, filtered dumps are expected to be small deltas
+	if filter.IsEmpty() {
+		if cachedData := app.cacheStore.Get(cacheKey); cachedData != nil {
+			return cachedData.(TrainedData)
+		}
 	}
 
 	// Fetch edit group data
@@ -185,7 +415,7 @@ func calculateTrainingDump(app *App) TrainedData {
 	}
 
 	data := TrainedData{}
-	for _, editGroup := range allEditGroups {
+	for _, editGroup := range filter.FilterEditGroups(allEditGroups) {
 		editGroupEdits, err := app.dbh.LookupEditsByGroupId(editGroup.Id)
 		if err != nil {
 			panic(err)
@@ -193,61 +423,137 @@ func calculateTrainingDump(app *App) TrainedData {
 
 		data[editGroup.Id] = map[int]bool{}
 		for _, e := range editGroupEdits {
-			if e.ReviewedClassification() != db.EDIT_CLASSIFICATION_UNKNOWN {
+			if e.ReviewedClassification() != db.EDIT_CLASSIFICATION_UNKNOWN && filter.IncludesEdit(e) {
 				data[editGroup.Id][e.Id] = e.ReviewedClassification() == db.EDIT_CLASSIFICATION_VANDALISM
 			}
 		}
 	}
 
-	app.cacheStore.Set("api-training-dump", data, time.Hour)
+	if filter.IsEmpty() {
+		app.cacheStore.Set(cacheKey, data, time.Hour)
+	}
 	return data
 }
 
-func (app *App) ApiExportDumpHandler(w http.ResponseWriter, r *http.Request) {
-	if _, err := w.Write([]byte(xml.Header)); err != nil {
+func calculateTrainingDelta(app *App, filter *exportFilter) TrainedDataDelta {
+	allEditGroups, err := app.dbh.FetchAllEditGroups()
+	if err != nil {
 		panic(err)
 	}
-	encoder := xml.NewEncoder(w)
-	encoder.Indent("", "\t")
 
-	data := calculateDataDump(app, false)
-	if err := encoder.Encode(&data); err != nil {
+	data := TrainedDataDelta{}
+	for _, editGroup := range filter.FilterEditGroups(allEditGroups) {
+		editGroupEdits, err := app.dbh.LookupEditsByGroupId(editGroup.Id)
+		if err != nil {
+			panic(err)
+		}
+
+		data[editGroup.Id] = map[int]interface{}{}
+		for _, e := range editGroupEdits {
+			if filter.IncludesLabelRemoval(e) {
+				data[editGroup.Id][e.Id] = ConvertClassificationToString(db.EDIT_CLASSIFICATION_UNKNOWN)
+				continue
+			}
+			if e.ReviewedClassification() != db.EDIT_CLASSIFICATION_UNKNOWN && filter.IncludesEdit(e) {
+				data[editGroup.Id][e.Id] = e.ReviewedClassification() == db.EDIT_CLASSIFICATION_VANDALISM
+			}
+		}
+	}
+	return data
+}
+
+func (app *App) ApiExportDumpHandler(w http.ResponseWriter, r *http.Request) {
+	filter := app.loadExportFilter(w, r)
+	if filter == nil {
+		return
+	}
+	if format := r.URL.Query().Get("format"); format != "" {
+		app.serveDatasetExport(w, format, filter, false)
+		return
+	}
+
+	source, err := newDbDumpSource(app, false, filter)
+	if err != nil {
+		panic(err)
+	}
+	if err := writeXmlDump(w, source, false); err != nil {
 		panic(err)
 	}
 }
 
 func (app *App) ApiExportDoneHandler(w http.ResponseWriter, r *http.Request) {
-	if _, err := w.Write([]byte(xml.Header)); err != nil {
-		panic(err)
+	filter := app.loadExportFilter(w, r)
+	if filter == nil {
+		return
+	}
+	if format := r.URL.Query().Get("format"); format != "" {
+		app.serveDatasetExport(w, format, filter, true)
+		return
 	}
-	encoder := xml.NewEncoder(w)
-	encoder.Indent("", "\t")
 
-	data := calculateDataDump(app, true)
-	if err := encoder.Encode(&data); err != nil {
+	source, err := newDbDumpSource(app, true, filter)
+	if err != nil {
+		panic(err)
+	}
+	if err := writeXmlDump(w, source, true); err != nil {
 		panic(err)
 	}
 }
 
 func (app *App) ApiExportDumpJsonHandler(w http.ResponseWriter, r *http.Request) {
+	filter := app.loadExportFilter(w, r)
+	if filter == nil {
+		return
+	}
+	if format := r.URL.Query().Get("format"); format != "" {
+		app.serveDatasetExport(w, format, filter, false)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
-	data := calculateDataDump(app, false)
-	if err := json.NewEncoder(w).Encode(&data); err != nil {
+	source, err := newDbDumpSource(app, false, filter)
+	if err != nil {
+		panic(err)
+	}
+	if err := writeJsonDump(w, source, false); err != nil {
 		panic(err)
 	}
 }
 
 func (app *App) ApiExportDoneJsonHandler(w http.ResponseWriter, r *http.Request) {
+	filter := app.loadExportFilter(w, r)
+	if filter == nil {
+		return
+	}
+	if format := r.URL.Query().Get("format"); format != "" {
+		app.serveDatasetExport(w, format, filter, true)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
-	data := calculateDataDump(app, true)
-	if err := json.NewEncoder(w).Encode(&data); err != nil {
+	source, err := newDbDumpSource(app, true, filter)
+	if err != nil {
+		panic(err)
+	}
+	if err := writeJsonDump(w, source, true); err != nil {
 		panic(err)
 	}
 }
 
 func (app *App) ApiExportTrainerJsonHandler(w http.ResponseWriter, r *http.Request) {
+	filter := app.loadExportFilter(w, r)
+	if filter == nil {
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
-	if err := json.NewEncoder(w).Encode(calculateTrainingDump(app)); err != nil {
+	if filter.Since != nil {
+		if err := json.NewEncoder(w).Encode(calculateTrainingDelta(app, filter)); err != nil {
+			panic(err)
+		}
+		return
+	}
+	if err := json.NewEncoder(w).Encode(calculateTrainingDump(app, filter)); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,159 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"github.com/cluebotng/reviewng/export"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// forEachDatasetRow flattens the filtered edits of every group, joining stored training data when required
+func forEachDatasetRow(app *App, filter *exportFilter, done bool, withTrainingData bool, fn func(*export.Row) error) error {
+	// Comments and users are not part of a row, so the classifications are never joined
+	source, err := newDbDumpSource(app, true, filter)
+	if err != nil {
+		return err
+	}
+
+	editGroups, err := source.EditGroups()
+	if err != nil {
+		return err
+	}
+
+	section := exportSectionEdits
+	if done {
+		section = exportSectionDone
+	}
+
+	for _, editGroup := range editGroups {
+		var trainingData *db.TrainingDataCursor
+		var trainingDataEditId int
+		var pendingTrainingData *db.TrainingData
+		if withTrainingData {
+			if trainingData, err = app.dbh.StreamTrainingDataByGroupId(editGroup.Id); err != nil {
+				return err
+			}
+			if trainingDataEditId, pendingTrainingData, err = trainingData.Next(); err != nil {
+				trainingData.Close()
+				return err
+			}
+		}
+
+		// Edits are produced in edit id order, as is the training data
+		err := source.ForEachEdit(editGroup, section, func(edit Edit) error {
+			var err error
+			row := &export.Row{
+				EditId:                 edit.Id,
+				EditGroupId:            editGroup.Id,
+				EditGroup:              editGroup.Name,
+				Weight:                 edit.Weight,
+				Required:               edit.Required,
+				Constructive:           edit.Constructive,
+				Skipped:                edit.Skipped,
+				Vandalism:              edit.Vandalism,
+				OriginalClassification: edit.OriginalClassification,
+				ReviewedClassification: edit.RealClassification,
+			}
+
+			for pendingTrainingData != nil && trainingDataEditId < edit.Id {
+				if trainingDataEditId, pendingTrainingData, err = trainingData.Next(); err != nil {
+					return err
+				}
+			}
+			if pendingTrainingData != nil && trainingDataEditId == edit.Id {
+				row.TrainingData = pendingTrainingData
+			}
+			return fn(row)
+		})
+		if trainingData != nil {
+			trainingData.Close()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeDatasetExport(w io.Writer, app *App, format *export.Format, filter *exportFilter, done bool) error {
+	exporter := format.New(w)
+	if err := exporter.WriteHeader(); err != nil {
+		return err
+	}
+
+	lastEditGroupId := 0
+	if err := forEachDatasetRow(app, filter, done, format.NeedsTrainingData, func(row *export.Row) error {
+		if row.EditGroupId != lastEditGroupId {
+			lastEditGroupId = row.EditGroupId
+			flushWriter(w)
+		}
+		return exporter.WriteRow(row)
+	}); err != nil {
+		return err
+	}
+	return exporter.Close()
+}
+
+func unknownExportFormatError(name string) error {
+	return fmt.Errorf("unknown format: %s (expected one of %s)", name, strings.Join(export.FormatNames(), ", "))
+}
+
+func (app *App) serveDatasetExport(w http.ResponseWriter, name string, filter *exportFilter, done bool) {
+	format := export.LookupFormat(name)
+	if format == nil {
+		http.Error(w, unknownExportFormatError(name).Error(), 400)
+		return
+	}
+
+	fileName := "dump"
+	if done {
+		fileName = "done"
+	}
+	w.Header().Set("Content-Type", format.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.%s\"", fileName, format.Extension))
+	if err := writeDatasetExport(w, app, format, filter, done); err != nil {
+		panic(err)
+	}
+}
+
+// ExportDataset writes an export from the command line, taking the same filters as the export routes
+func (app *App) ExportDataset(w io.Writer, name string, done bool, query url.Values) error {
+	format := export.LookupFormat(name)
+	if format == nil {
+		return unknownExportFormatError(name)
+	}
+
+	filter, err := parseExportFilter(query)
+	if err != nil {
+		return err
+	}
+	if err := filter.load(app); err != nil {
+		return err
+	}
+	return writeDatasetExport(w, app, format, filter, done)
+}
@@ -0,0 +1,196 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/xml"
+	"github.com/cluebotng/reviewng/db"
+	"io"
+	"net/http"
+)
+
+// WPEdit is a single edit in the editset format read by the core trainer
+type WPEdit struct {
+	EditType          string `xml:"EditType"`
+	EditID            int    `xml:"EditID"`
+	Comment           string `xml:"comment"`
+	User              string `xml:"user"`
+	UserEditCount     int    `xml:"user_edit_count"`
+	UserDistinctPages int    `xml:"user_distinct_pages"`
+	UserWarns         int    `xml:"user_warns"`
+	PrevUser          string `xml:"prev_user"`
+	UserRegTime       int    `xml:"user_reg_time"`
+	Common            struct {
+		PageMadeTime        int    `xml:"page_made_time"`
+		Title               string `xml:"title"`
+		Namespace           string `xml:"namespace"`
+		Creator             string `xml:"creator"`
+		NumRecentEdits      int    `xml:"num_recent_edits"`
+		NumRecentReversions int    `xml:"num_recent_reversions"`
+	} `xml:"common"`
+	Current struct {
+		Minor     bool   `xml:"minor"`
+		Timestamp int    `xml:"timestamp"`
+		Text      string `xml:"text"`
+	} `xml:"current"`
+	Previous struct {
+		Timestamp int    `xml:"timestamp"`
+		Text      string `xml:"text"`
+	} `xml:"previous"`
+	IsVandalism bool `xml:"isVandalism"`
+}
+
+func newWPEdit(editId int, td *db.TrainingData, isVandalism bool) WPEdit {
+	wpEdit := WPEdit{
+		EditType:          "change",
+		EditID:            editId,
+		Comment:           td.Current.Comment,
+		User:              td.Current.User.Name,
+		UserEditCount:     td.Current.User.EditCount,
+		UserDistinctPages: td.Current.User.DistinctPagesCount,
+		UserWarns:         td.Current.User.WarningCount,
+		PrevUser:          td.Previous.User.Name,
+		UserRegTime:       td.Current.User.RegistrationTime,
+		IsVandalism:       isVandalism,
+	}
+	wpEdit.Common.PageMadeTime = td.Page.CreationTime
+	wpEdit.Common.Title = td.Page.Title
+	wpEdit.Common.Namespace = td.Page.Namespace
+	wpEdit.Common.Creator = td.Page.Creator
+	wpEdit.Common.NumRecentEdits = td.Page.RecentEditCount
+	wpEdit.Common.NumRecentReversions = td.Page.RecentReversionCount
+	wpEdit.Current.Minor = td.Current.Minor
+	wpEdit.Current.Timestamp = td.Current.Timestamp
+	wpEdit.Current.Text = td.Current.Text
+	wpEdit.Previous.Timestamp = td.Previous.Timestamp
+	wpEdit.Previous.Text = td.Previous.Text
+	return wpEdit
+}
+
+// forEachLabelledTrainingEdit streams the labelled edits of a group joined with their stored training data
+func forEachLabelledTrainingEdit(app *App, editGroup *db.EditGroup, filter *exportFilter, fn func(*db.Edit, *db.TrainingData) error) error {
+	edits, err := app.dbh.StreamEditsByGroupId(editGroup.Id)
+	if err != nil {
+		return err
+	}
+	defer edits.Close()
+
+	trainingData, err := app.dbh.StreamTrainingDataByGroupId(editGroup.Id)
+	if err != nil {
+		return err
+	}
+	defer trainingData.Close()
+
+	// Both cursors are ordered by edit id
+	trainingDataEditId, pendingTrainingData, err := trainingData.Next()
+	if err != nil {
+		return err
+	}
+	for {
+		e, err := edits.Next()
+		if err != nil {
+			return err
+		}
+		if e == nil {
+			return nil
+		}
+
+		for pendingTrainingData != nil && trainingDataEditId < e.Id {
+			if trainingDataEditId, pendingTrainingData, err = trainingData.Next(); err != nil {
+				return err
+			}
+		}
+		if pendingTrainingData == nil || trainingDataEditId != e.Id {
+			continue
+		}
+
+		// Only vandalism and constructive labels are meaningful for training
+		reviewedClassification := e.ReviewedClassification()
+		if reviewedClassification != db.EDIT_CLASSIFICATION_VANDALISM && reviewedClassification != db.EDIT_CLASSIFICATION_CONSTRUCTIVE {
+			continue
+		}
+		if !filter.IncludesEdit(e) {
+			continue
+		}
+
+		if err := fn(e, pendingTrainingData); err != nil {
+			return err
+		}
+	}
+}
+
+func writeEditSet(w io.Writer, app *App, filter *exportFilter) error {
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "\t")
+
+	editSet := xml.StartElement{Name: xml.Name{Local: "WPEditSet"}}
+	if err := encoder.EncodeToken(editSet); err != nil {
+		return err
+	}
+
+	allEditGroups, err := app.dbh.FetchAllEditGroups()
+	if err != nil {
+		return err
+	}
+
+	// Edits may be in multiple groups, but should only be trained on once
+	seenEditIds := map[int]bool{}
+	for _, editGroup := range filter.FilterEditGroups(allEditGroups) {
+		if err := forEachLabelledTrainingEdit(app, editGroup, filter, func(e *db.Edit, td *db.TrainingData) error {
+			if _, ok := seenEditIds[e.Id]; ok {
+				return nil
+			}
+			seenEditIds[e.Id] = true
+
+			isVandalism := e.ReviewedClassification() == db.EDIT_CLASSIFICATION_VANDALISM
+			return encoder.EncodeElement(newWPEdit(e.Id, td, isVandalism), xml.StartElement{Name: xml.Name{Local: "WPEdit"}})
+		}); err != nil {
+			return err
+		}
+
+		if err := encoder.Flush(); err != nil {
+			return err
+		}
+		flushWriter(w)
+	}
+
+	if err := encoder.EncodeToken(editSet.End()); err != nil {
+		return err
+	}
+	return encoder.Flush()
+}
+
+func (app *App) ApiExportEditSetHandler(w http.ResponseWriter, r *http.Request) {
+	filter := app.loadExportFilter(w, r)
+	if filter == nil {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=UTF-8")
+	if err := writeEditSet(w, app, filter); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,224 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// exportFilter restricts an export to a subset of edits, decoded from the request query
+type exportFilter struct {
+	EditGroupIds    map[int]bool
+	Classifications map[string]bool
+	From            *time.Time
+	To              *time.Time
+	Since           *int
+
+	// Resolved against the database by load
+	NextCursor     int
+	changedEditIds map[int]bool
+	dateEditIds    map[int]bool
+}
+
+func splitQueryValues(values []string) []string {
+	split := []string{}
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				split = append(split, part)
+			}
+		}
+	}
+	return split
+}
+
+func parseExportTime(value string) (*time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid time: %s", value)
+}
+
+func parseExportFilter(query url.Values) (*exportFilter, error) {
+	filter := &exportFilter{}
+
+	if values := splitQueryValues(query["edit_group"]); len(values) > 0 {
+		filter.EditGroupIds = map[int]bool{}
+		for _, value := range values {
+			id, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid edit_group: %s", value)
+			}
+			filter.EditGroupIds[id] = true
+		}
+	}
+
+	if values := splitQueryValues(query["classification"]); len(values) > 0 {
+		filter.Classifications = map[string]bool{}
+		for _, value := range values {
+			value = strings.ToUpper(value)
+			if value != "V" && value != "C" && value != "S" && value != "U" {
+				return nil, fmt.Errorf("invalid classification: %s", value)
+			}
+			filter.Classifications[value] = true
+		}
+	}
+
+	if value := query.Get("from"); value != "" {
+		from, err := parseExportTime(value)
+		if err != nil {
+			return nil, err
+		}
+		filter.From = from
+	}
+
+	if value := query.Get("to"); value != "" {
+		to, err := parseExportTime(value)
+		if err != nil {
+			return nil, err
+		}
+		filter.To = to
+	}
+
+	if value := query.Get("since"); value != "" {
+		since, err := strconv.Atoi(value)
+		if err != nil || since < 0 {
+			return nil, fmt.Errorf("invalid since: %s", value)
+		}
+		filter.Since = &since
+	}
+
+	return filter, nil
+}
+
+// load resolves the cursor and time based filters to edit ids
+func (f *exportFilter) load(app *App) error {
+	// Taken first so changes made while exporting are included again in the next delta
+	cursor, err := app.dbh.CalculateEditLabelCursor()
+	if err != nil {
+		return err
+	}
+	f.NextCursor = cursor
+
+	if f.Since != nil {
+		if f.changedEditIds, err = app.dbh.LookupEditIdsLabelChangedSince(*f.Since); err != nil {
+			return err
+		}
+	}
+
+	if f.From != nil || f.To != nil {
+		from, to := time.Unix(0, 0), time.Now().Add(time.Hour)
+		if f.From != nil {
+			from = *f.From
+		}
+		if f.To != nil {
+			to = *f.To
+		}
+		if f.dateEditIds, err = app.dbh.LookupEditIdsClassifiedBetween(from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *exportFilter) IsEmpty() bool {
+	return f.EditGroupIds == nil && f.Classifications == nil && f.From == nil && f.To == nil && f.Since == nil
+}
+
+func (f *exportFilter) IncludesEditGroup(editGroup *db.EditGroup) bool {
+	if f.EditGroupIds == nil {
+		return true
+	}
+	_, ok := f.EditGroupIds[editGroup.Id]
+	return ok
+}
+
+func (f *exportFilter) IncludesEdit(edit *db.Edit) bool {
+	if f.Classifications != nil {
+		if _, ok := f.Classifications[ConvertClassificationToString(edit.ReviewedClassification())]; !ok {
+			return false
+		}
+	}
+	if f.changedEditIds != nil {
+		if _, ok := f.changedEditIds[edit.Id]; !ok {
+			return false
+		}
+	}
+	if f.dateEditIds != nil {
+		if _, ok := f.dateEditIds[edit.Id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IncludesLabelRemoval is true for edits which lost their label since the cursor, they are always part of a delta
+// regardless of the classification filter so clients are told to drop the label
+func (f *exportFilter) IncludesLabelRemoval(edit *db.Edit) bool {
+	if f.changedEditIds == nil || edit.ReviewedClassification() != db.EDIT_CLASSIFICATION_UNKNOWN {
+		return false
+	}
+	if _, ok := f.changedEditIds[edit.Id]; !ok {
+		return false
+	}
+	if f.dateEditIds != nil {
+		if _, ok := f.dateEditIds[edit.Id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *exportFilter) FilterEditGroups(editGroups []*db.EditGroup) []*db.EditGroup {
+	filtered := []*db.EditGroup{}
+	for _, editGroup := range editGroups {
+		if f.IncludesEditGroup(editGroup) {
+			filtered = append(filtered, editGroup)
+		}
+	}
+	return filtered
+}
+
+// loadExportFilter decodes and resolves the request filter, writing the cursor header for the client
+func (app *App) loadExportFilter(w http.ResponseWriter, r *http.Request) *exportFilter {
+	filter, err := parseExportFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return nil
+	}
+
+	if err := filter.load(app); err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("X-Export-Cursor", strconv.Itoa(filter.NextCursor))
+	return filter
+}
@@ -0,0 +1,100 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type leaderboardPosition struct {
+	Username string `json:"username"`
+	Count    int    `json:"count"`
+}
+
+type leaderboards struct {
+	Last7Days  []leaderboardPosition `json:"last_7_days"`
+	Last30Days []leaderboardPosition `json:"last_30_days"`
+	AllTime    []leaderboardPosition `json:"all_time"`
+}
+
+func rankLeaderboard(entries []*db.LeaderboardEntry, count func(*db.LeaderboardEntry) int) []leaderboardPosition {
+	positions := []leaderboardPosition{}
+	for _, entry := range entries {
+		if count(entry) > 0 {
+			positions = append(positions, leaderboardPosition{entry.Username, count(entry)})
+		}
+	}
+	sort.SliceStable(positions, func(i, j int) bool {
+		return positions[i].Count > positions[j].Count
+	})
+	return positions
+}
+
+func calculateLeaderboards(app *App, editGroupId int) leaderboards {
+	cacheKey := fmt.Sprintf("leaderboards-%d", editGroupId)
+	if cachedData := app.cacheStore.Get(cacheKey); cachedData != nil {
+		return cachedData.(leaderboards)
+	}
+
+	entries, err := app.dbh.CalculateLeaderboard(editGroupId)
+	if err != nil {
+		panic(err)
+	}
+
+	data := leaderboards{
+		Last7Days:  rankLeaderboard(entries, func(e *db.LeaderboardEntry) int { return e.Last7Days }),
+		Last30Days: rankLeaderboard(entries, func(e *db.LeaderboardEntry) int { return e.Last30Days }),
+		AllTime:    rankLeaderboard(entries, func(e *db.LeaderboardEntry) int { return e.AllTime }),
+	}
+
+	app.cacheStore.Set(cacheKey, data, time.Minute*5)
+	return data
+}
+
+func (app *App) ApiLeaderboardHandler(w http.ResponseWriter, r *http.Request) {
+	editGroupId := 0
+	if val := r.URL.Query().Get("edit_group"); val != "" {
+		id, err := strconv.Atoi(val)
+		if err != nil {
+			http.Error(w, "Bad Request", 400)
+			return
+		}
+		editGroupId = id
+	}
+
+	response, err := json.Marshal(calculateLeaderboards(app, editGroupId))
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
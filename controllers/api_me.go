@@ -0,0 +1,119 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/json"
+	"github.com/cluebotng/reviewng/db"
+	"net/http"
+	"time"
+)
+
+type userRecentClassification struct {
+	EditId         int       `json:"edit_id"`
+	Classification int       `json:"classification"`
+	Comment        string    `json:"comment"`
+	Created        time.Time `json:"created"`
+}
+
+type userDashboard struct {
+	Username           string                         `json:"username"`
+	Total              int                            `json:"total"`
+	EditGroups         []*db.UserGroupClassifications `json:"edit_groups"`
+	AccuracyCount      int                            `json:"accuracy_count"`
+	AccuracyPercentage float32                        `json:"accuracy_percentage"`
+	Recent             []userRecentClassification     `json:"recent"`
+	Disagreements      []*db.UserDisagreement         `json:"disagreements"`
+	DailyActivity      []*db.UserDailyActivity        `json:"daily_activity"`
+}
+
+func calculateUserDashboard(app *App, user *db.User) userDashboard {
+	total, err := app.dbh.CalculateTotalUserClassifications(user)
+	if err != nil {
+		panic(err)
+	}
+
+	editGroups, err := app.dbh.CalculateUserClassificationsByGroup(user)
+	if err != nil {
+		panic(err)
+	}
+
+	accuracy, err := app.dbh.CalculateUserClassificationAccuracy(user)
+	if err != nil {
+		panic(err)
+	}
+
+	recentClassifications, err := app.dbh.LookupRecentUserClassificationsByUserId(user.Id, 50)
+	if err != nil {
+		panic(err)
+	}
+	recent := []userRecentClassification{}
+	for _, c := range recentClassifications {
+		recent = append(recent, userRecentClassification{
+			EditId:         c.EditId,
+			Classification: c.Classification,
+			Comment:        c.Comment,
+			Created:        c.Created,
+		})
+	}
+
+	disagreements, err := app.dbh.CalculateUserClassificationDisagreements(user)
+	if err != nil {
+		panic(err)
+	}
+
+	dailyActivity, err := app.dbh.CalculateUserDailyActivity(user, 30)
+	if err != nil {
+		panic(err)
+	}
+
+	return userDashboard{
+		Username:           user.Username,
+		Total:              total,
+		EditGroups:         editGroups,
+		AccuracyCount:      accuracy.EditCount,
+		AccuracyPercentage: accuracy.Percentage,
+		Recent:             recent,
+		Disagreements:      disagreements,
+		DailyActivity:      dailyActivity,
+	}
+}
+
+func (app *App) ApiMeHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	response, err := json.Marshal(calculateUserDashboard(app, user))
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,161 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/json"
+	"github.com/cluebotng/reviewng/db"
+	"net/http"
+)
+
+func (app *App) canTakeQualification(user *db.User) bool {
+	return app.config.Qualification.Enabled && !user.Approved && !user.Suspended
+}
+
+func (app *App) lookupPendingQualificationEdits(user *db.User) ([]*db.QualificationEdit, []*db.QualificationEdit) {
+	edits, err := app.dbh.LookupQualificationEdits(user.Id, app.config.Qualification.EditGroup, app.config.Qualification.Edits)
+	if err != nil {
+		panic(err)
+	}
+
+	practiceClassifications, err := app.dbh.LookupQualificationClassificationsByUserId(user.Id)
+	if err != nil {
+		panic(err)
+	}
+	knownUserEdits := map[int]bool{}
+	for _, c := range practiceClassifications {
+		knownUserEdits[c.EditId] = true
+	}
+
+	pendingEdits := []*db.QualificationEdit{}
+	for _, edit := range edits {
+		if _, ok := knownUserEdits[edit.EditId]; !ok {
+			pendingEdits = append(pendingEdits, edit)
+		}
+	}
+	return edits, pendingEdits
+}
+
+func (app *App) ApiQualificationNextHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Already approved or not running qualifications, return an error
+	if !app.canTakeQualification(user) {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	_, pendingEdits := app.lookupPendingQualificationEdits(user)
+	if len(pendingEdits) == 0 {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	response, err := json.Marshal(map[string]interface{}{
+		"edit_id":   pendingEdits[0].EditId,
+		"remaining": len(pendingEdits),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) ApiQualificationClassificationCreateHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Already approved or not running qualifications, return an error
+	if !app.canTakeQualification(user) {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	// Already scored, wait on an admin
+	qualification, err := app.dbh.LookupUserQualification(user.Id)
+	if err != nil {
+		panic(err)
+	}
+	if qualification != nil {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	practiceClassification := struct {
+		EditId         int `json:"edit_id"`
+		Classification int `json:"classification"`
+	}{}
+
+	// Decode the request
+	if err := json.NewDecoder(r.Body).Decode(&practiceClassification); err != nil {
+		panic(err)
+	}
+
+	// Only accept votes for the next edit in the practice set
+	allEdits, pendingEdits := app.lookupPendingQualificationEdits(user)
+	if len(pendingEdits) == 0 || pendingEdits[0].EditId != practiceClassification.EditId {
+		http.Error(w, "Bad Request", 400)
+		return
+	}
+
+	// Practice votes are stored separately so they never reach the consensus
+	if err := app.dbh.CreateQualificationClassification(db.QualificationClassification{
+		UserId:         user.Id,
+		EditId:         practiceClassification.EditId,
+		Classification: practiceClassification.Classification,
+	}); err != nil {
+		panic(err)
+	}
+
+	result := map[string]interface{}{"require_confirmation": false, "completed": false}
+	if len(pendingEdits) == 1 {
+		qualification, err := app.dbh.ScoreUserQualification(user, allEdits, app.config.Qualification.Threshold)
+		if err != nil {
+			panic(err)
+		}
+		result["completed"] = true
+		result["approved"] = qualification.Outcome == db.QUALIFICATION_PASSED
+	}
+
+	response, err := json.Marshal(result)
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
@@ -23,64 +23,201 @@ package controllers
 // SOFTWARE.
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"github.com/cluebotng/reviewng/db"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"time"
 )
 
-func (app *App) ApiReportImportHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := http.NewRequest("GET", "https://cluebotng.toolforge.org/api/?action=review.export", nil)
+// parseReportImportIds decodes the list of edit ids, ids which aren't positive integers are returned as invalid
+func parseReportImportIds(body []byte) ([]int, []string, error) {
+	rawIds := []json.RawMessage{}
+	if err := json.Unmarshal(body, &rawIds); err != nil {
+		return nil, nil, fmt.Errorf("decode: %v", err)
+	}
+
+	editIds, invalid := []int{}, []string{}
+	seenEditIds := map[int]bool{}
+	for _, rawId := range rawIds {
+		var value interface{}
+		decoder := json.NewDecoder(bytes.NewReader(rawId))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			invalid = append(invalid, string(rawId))
+			continue
+		}
+
+		// Ids are sent as numbers, but accept them quoted
+		editId, err := 0, fmt.Errorf("not an id")
+		switch v := value.(type) {
+		case json.Number:
+			editId, err = strconv.Atoi(v.String())
+		case string:
+			editId, err = strconv.Atoi(v)
+		}
+		if err != nil || editId <= 0 {
+			invalid = append(invalid, string(rawId))
+			continue
+		}
+
+		if _, ok := seenEditIds[editId]; ok {
+			continue
+		}
+		seenEditIds[editId] = true
+		editIds = append(editIds, editId)
+	}
+	return editIds, invalid, nil
+}
+
+func fetchReportImportIds(source string) ([]byte, error) {
+	req, err := http.NewRequest("GET", source, nil)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	req.Header.Set("User-Agent", "ClueBot NG Review NG/1.0")
 
-	resp, err := http.DefaultClient.Do(req)
+	client := &http.Client{Timeout: 60 * time.Second}
+	resp, err := client.Do(req)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	if err := resp.Body.Close(); err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	// Create a map of known ids
-	knownEditIds := map[int]bool{}
-	allEdits, err := app.dbh.FetchAllEdits()
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("error returned from report interface: %d", resp.StatusCode)
+	}
+	return body, nil
+}
+
+func reportImportClassification(name string) int {
+	switch name {
+	case "vandalism":
+		return db.EDIT_CLASSIFICATION_VANDALISM
+	case "skipped":
+		return db.EDIT_CLASSIFICATION_SKIPPED
+	}
+	return db.EDIT_CLASSIFICATION_CONSTRUCTIVE
+}
+
+// runReportImport creates edits for everything the report interface has marked for review, recording the run
+func (app *App) runReportImport(dryRun bool) (*db.ReportImport, error) {
+	config := app.config.ReportImport
+	run := &db.ReportImportRun{Started: time.Now(), DryRun: dryRun, Source: config.Url, EditGroup: config.EditGroup}
+
+	reportImport, err := app.importReportEditIds(dryRun)
+	if reportImport != nil {
+		run.New, run.Existing, run.Invalid = len(reportImport.New), len(reportImport.Existing), len(reportImport.Invalid)
+	}
 	if err != nil {
-		panic(err)
+		run.Error = err.Error()
 	}
-	for _, edit := range allEdits {
-		knownEditIds[edit.Id] = false
+
+	run.Finished = time.Now()
+	if err := app.dbh.CreateReportImportRun(run); err != nil {
+		return nil, err
 	}
+	return reportImport, err
+}
+
+func (app *App) importReportEditIds(dryRun bool) (*db.ReportImport, error) {
+	config := app.config.ReportImport
 
 	// Fetch the edit group we log these into
-	eg, err := app.dbh.LookupEditGroupByName("Report Interface Import")
+	editGroup, err := app.dbh.LookupEditGroupByName(config.EditGroup)
+	if err != nil {
+		return nil, err
+	}
+	if editGroup == nil {
+		return nil, fmt.Errorf("edit group not found: %s", config.EditGroup)
+	}
+
+	body, err := fetchReportImportIds(config.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	editIds, invalid, err := parseReportImportIds(body)
+	if err != nil {
+		return nil, err
+	}
+
+	reportImport, err := app.dbh.ImportReportEdits(editIds, editGroup.Id, config.Required, reportImportClassification(config.Classification), dryRun)
+	if err != nil {
+		return nil, err
+	}
+	reportImport.Invalid = invalid
+	return reportImport, nil
+}
+
+// importReportEdits is the scheduled import
+func (app *App) importReportEdits() error {
+	_, err := app.runReportImport(false)
+	return err
+}
+
+// ApiReportImportHandler starts the import job, with dry_run the import is run inline for an admin and the summary returned
+func (app *App) ApiReportImportHandler(w http.ResponseWriter, r *http.Request) {
+	dryRun := r.URL.Query().Get("dry_run") == "1" || r.URL.Query().Get("dry_run") == "true"
+	if !dryRun {
+		app.writeJobTriggered(w, jobReportImport)
+		return
+	}
+
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	reportImport, err := app.runReportImport(true)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	response, err := json.Marshal(reportImport)
 	if err != nil {
 		panic(err)
 	}
 
-	// Create entries for everything we don't know about
-	editIds := []int{}
-	if err := json.Unmarshal(body, &editIds); err != nil {
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
 		panic(err)
 	}
+}
 
-	for _, newEditId := range editIds {
-		// Already have this edit, ignore it
-		if _, ok := knownEditIds[newEditId]; ok {
-			continue
-		}
+func (app *App) ApiReportImportRunsHandler(w http.ResponseWriter, r *http.Request) {
+	runs, err := app.dbh.LookupRecentReportImportRuns(50)
+	if err != nil {
+		panic(err)
+	}
 
-		// Create a new entry
-		if err := app.dbh.CreateEdit(newEditId, eg, 2, db.EDIT_CLASSIFICATION_CONSTRUCTIVE); err != nil {
-			panic(err)
-		}
+	response, err := json.Marshal(runs)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
 	}
 }
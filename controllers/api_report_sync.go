@@ -0,0 +1,234 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const reportSyncVersion = 2
+const reportSyncDefaultLimit = 500
+const reportSyncMaxLimit = 5000
+
+type reportSyncVotes struct {
+	Vandalism    int `json:"vandalism"`
+	Constructive int `json:"constructive"`
+	Skipped      int `json:"skipped"`
+}
+
+type reportSyncReview struct {
+	User           string    `json:"user"`
+	Classification string    `json:"classification"`
+	Comment        string    `json:"comment"`
+	Created        time.Time `json:"created"`
+}
+
+type reportSyncEdit struct {
+	EditId   int                 `json:"edit_id"`
+	Status   string              `json:"status"`
+	Label    *string             `json:"label"`
+	Required int                 `json:"required"`
+	Votes    reportSyncVotes     `json:"votes"`
+	Reviews  []*reportSyncReview `json:"reviews"`
+}
+
+type reportSyncResponse struct {
+	Version int               `json:"version"`
+	Cursor  int               `json:"cursor"`
+	More    bool              `json:"more"`
+	Edits   []*reportSyncEdit `json:"edits"`
+}
+
+func reportSyncClassification(classification int) string {
+	switch classification {
+	case db.EDIT_CLASSIFICATION_VANDALISM:
+		return "vandalism"
+	case db.EDIT_CLASSIFICATION_CONSTRUCTIVE:
+		return "constructive"
+	case db.EDIT_CLASSIFICATION_SKIPPED:
+		return "skipped"
+	}
+	return "unknown"
+}
+
+// reportSyncStatus is queued without votes, partial until the required votes, contested when the votes don't agree and otherwise done
+func reportSyncStatus(edit *db.Edit) string {
+	if edit.ReviewedClassification() != db.EDIT_CLASSIFICATION_UNKNOWN {
+		return "done"
+	}
+	if edit.UserClassificationsVandalism+edit.UserClassificationsConstructive+edit.UserClassificationsSkipped == 0 {
+		return "queued"
+	}
+	if db.MaxInt(edit.UserClassificationsConstructive, db.MaxInt(edit.UserClassificationsVandalism, edit.UserClassificationsSkipped)) < edit.Required {
+		return "partial"
+	}
+	return "contested"
+}
+
+func newReportSyncEdit(edit *db.Edit, reviews []*db.ReportReview) *reportSyncEdit {
+	syncEdit := &reportSyncEdit{
+		EditId:   edit.Id,
+		Status:   reportSyncStatus(edit),
+		Required: edit.Required,
+		Votes: reportSyncVotes{
+			Vandalism:    edit.UserClassificationsVandalism,
+			Constructive: edit.UserClassificationsConstructive,
+			Skipped:      edit.UserClassificationsSkipped,
+		},
+		Reviews: []*reportSyncReview{},
+	}
+	if syncEdit.Status == "done" {
+		label := reportSyncClassification(edit.ReviewedClassification())
+		syncEdit.Label = &label
+	}
+	for _, review := range reviews {
+		syncEdit.Reviews = append(syncEdit.Reviews, &reportSyncReview{
+			User:           review.Username,
+			Classification: reportSyncClassification(review.Classification),
+			Comment:        review.Comment,
+			Created:        review.Created,
+		})
+	}
+	return syncEdit
+}
+
+// buildReportSnapshot returns every imported edit, with the cursor taken first so changes made meanwhile are sent again
+func (app *App) buildReportSnapshot(editGroupId int) (*reportSyncResponse, error) {
+	cursor, err := app.dbh.CalculateEditLabelCursor()
+	if err != nil {
+		return nil, err
+	}
+
+	edits, err := app.dbh.LookupEditsByGroupId(editGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := app.dbh.LookupReportReviews(editGroupId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &reportSyncResponse{Version: reportSyncVersion, Cursor: cursor, Edits: []*reportSyncEdit{}}
+	for _, edit := range edits {
+		response.Edits = append(response.Edits, newReportSyncEdit(edit, reviews[edit.Id]))
+	}
+	return response, nil
+}
+
+// buildReportChanges returns the imported edits changed after the cursor, in the order they were (last) changed
+func (app *App) buildReportChanges(editGroupId, since, limit int) (*reportSyncResponse, error) {
+	changes, err := app.dbh.LookupEditChangesSince(editGroupId, since, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &reportSyncResponse{Version: reportSyncVersion, Cursor: since, More: len(changes) == limit, Edits: []*reportSyncEdit{}}
+	if len(changes) == 0 {
+		return response, nil
+	}
+	response.Cursor = changes[len(changes)-1].Id
+
+	// An edit changed several times is sent once, in the position of its latest change
+	latestChange := map[int]int{}
+	for _, change := range changes {
+		latestChange[change.EditId] = change.Id
+	}
+	editIds := []int{}
+	for _, change := range changes {
+		if latestChange[change.EditId] == change.Id {
+			editIds = append(editIds, change.EditId)
+		}
+	}
+
+	reviews, err := app.dbh.LookupReportReviews(editGroupId, editIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, editId := range editIds {
+		edit, err := app.dbh.LookupEditById(editId)
+		if err != nil {
+			return nil, err
+		}
+		if edit == nil {
+			continue
+		}
+		response.Edits = append(response.Edits, newReportSyncEdit(edit, reviews[editId]))
+	}
+	return response, nil
+}
+
+func (app *App) ApiReportSyncHandler(w http.ResponseWriter, r *http.Request) {
+	since := 0
+	if value := r.URL.Query().Get("since"); value != "" {
+		var err error
+		if since, err = strconv.Atoi(value); err != nil || since < 0 {
+			http.Error(w, fmt.Sprintf("invalid since: %s", value), 400)
+			return
+		}
+	}
+
+	limit := reportSyncDefaultLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit <= 0 || limit > reportSyncMaxLimit {
+			http.Error(w, fmt.Sprintf("invalid limit: %s", value), 400)
+			return
+		}
+	}
+
+	editGroup, err := app.dbh.LookupEditGroupByName(app.config.ReportImport.EditGroup)
+	if err != nil {
+		panic(err)
+	}
+	if editGroup == nil {
+		http.Error(w, fmt.Sprintf("edit group not found: %s", app.config.ReportImport.EditGroup), 404)
+		return
+	}
+
+	var response *reportSyncResponse
+	if since == 0 {
+		response, err = app.buildReportSnapshot(editGroup.Id)
+	} else {
+		response, err = app.buildReportChanges(editGroup.Id, since, limit)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(body); err != nil {
+		panic(err)
+	}
+}
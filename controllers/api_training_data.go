@@ -24,6 +24,8 @@ package controllers
 
 import (
 	"encoding/json"
+	"github.com/cluebotng/reviewng/db"
+	"github.com/cluebotng/reviewng/features"
 	"github.com/gorilla/mux"
 	"net/http"
 	"strconv"
@@ -56,3 +58,93 @@ func (app *App) ApiTrainingDataHandler(w http.ResponseWriter, r *http.Request) {
 		panic(err)
 	}
 }
+
+func (app *App) ApiTrainingDataHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	// Decode the request
+	editId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+
+	provenance, err := app.dbh.LookupTrainingDataProvenanceByEditId(editId)
+	if err != nil {
+		panic(err)
+	}
+
+	if provenance == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	history, err := app.dbh.LookupTrainingDataHistoryByEditId(editId)
+	if err != nil {
+		panic(err)
+	}
+
+	response, err := json.Marshal(struct {
+		Current  *db.TrainingDataProvenance `json:"current"`
+		Previous []*db.TrainingDataVersion  `json:"previous"`
+	}{provenance, history})
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) ApiTrainingDataFeaturesHandler(w http.ResponseWriter, r *http.Request) {
+	// Decode the request
+	editId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+
+	editTrainingData, err := app.dbh.GetTrainingDataByEditId(editId)
+	if err != nil {
+		panic(err)
+	}
+
+	if editTrainingData == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	response, err := json.Marshal(map[string]interface{}{
+		"edit_id":  editId,
+		"features": features.ExtractMap(editTrainingData),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+// revalidateTrainingData re-runs validation over stored training data, after the rules change
+func (app *App) revalidateTrainingData() error {
+	_, err := app.dbh.RevalidateAllTrainingData()
+	return err
+}
+
+func (app *App) ApiTrainingDataIssuesHandler(w http.ResponseWriter, r *http.Request) {
+	issues, err := app.dbh.LookupTrainingDataIssues()
+	if err != nil {
+		panic(err)
+	}
+
+	response, err := json.Marshal(issues)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
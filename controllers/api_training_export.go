@@ -0,0 +1,148 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// Rows written between flushes of the response
+const trainingExportFlushRows = 500
+
+// trainingExportRow is one line of the training data export
+type trainingExportRow struct {
+	EditId       int              `json:"edit_id"`
+	Label        string           `json:"label"`
+	EditGroupIds []int            `json:"edit_groups"`
+	TrainingData *db.TrainingData `json:"training_data"`
+}
+
+// writeTrainingExport merges the training data with the edits and their groups, all read in edit id order
+func writeTrainingExport(w io.Writer, app *App, filter *exportFilter, afterEditId int) error {
+	editGroupIds := []int{}
+	for editGroupId := range filter.EditGroupIds {
+		editGroupIds = append(editGroupIds, editGroupId)
+	}
+	sort.Ints(editGroupIds)
+
+	trainingData, err := app.dbh.StreamTrainingData(afterEditId, editGroupIds)
+	if err != nil {
+		return err
+	}
+	defer trainingData.Close()
+
+	edits, err := app.dbh.StreamEdits(afterEditId)
+	if err != nil {
+		return err
+	}
+	defer edits.Close()
+
+	memberships, err := app.dbh.StreamEditGroupMemberships(afterEditId)
+	if err != nil {
+		return err
+	}
+	defer memberships.Close()
+
+	pendingEdit, err := edits.Next()
+	if err != nil {
+		return err
+	}
+	pendingMembership, err := memberships.Next()
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	rows := 0
+	for {
+		editId, td, err := trainingData.Next()
+		if err != nil {
+			return err
+		}
+		if td == nil {
+			return nil
+		}
+
+		for pendingEdit != nil && pendingEdit.Id < editId {
+			if pendingEdit, err = edits.Next(); err != nil {
+				return err
+			}
+		}
+
+		groups := []int{}
+		for pendingMembership != nil && pendingMembership.EditId <= editId {
+			if pendingMembership.EditId == editId {
+				groups = append(groups, pendingMembership.EditGroupId)
+			}
+			if pendingMembership, err = memberships.Next(); err != nil {
+				return err
+			}
+		}
+
+		// Unavailable edits are not returned by the edit cursor
+		if pendingEdit == nil || pendingEdit.Id != editId || !filter.IncludesEdit(pendingEdit) {
+			continue
+		}
+
+		if err := encoder.Encode(trainingExportRow{
+			EditId:       editId,
+			Label:        ConvertClassificationToString(pendingEdit.ReviewedClassification()),
+			EditGroupIds: groups,
+			TrainingData: td,
+		}); err != nil {
+			return err
+		}
+
+		if rows++; rows%trainingExportFlushRows == 0 {
+			flushWriter(w)
+		}
+	}
+}
+
+func (app *App) ApiTrainingExportHandler(w http.ResponseWriter, r *http.Request) {
+	// Resume after the last edit a previous export returned
+	afterEditId := 0
+	if value := r.URL.Query().Get("after"); value != "" {
+		var err error
+		if afterEditId, err = strconv.Atoi(value); err != nil || afterEditId < 0 {
+			http.Error(w, fmt.Sprintf("invalid after: %s", value), 400)
+			return
+		}
+	}
+
+	filter := app.loadExportFilter(w, r)
+	if filter == nil {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson; charset=UTF-8")
+	if err := writeTrainingExport(w, app, filter, afterEditId); err != nil {
+		panic(err)
+	}
+}
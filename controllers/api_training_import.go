@@ -26,60 +26,52 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/cluebotng/reviewng/db"
-	"io/ioutil"
-	"log"
+	"github.com/cluebotng/reviewng/training"
 	"net/http"
+	"sort"
+	"time"
 )
 
-func downloadTrainingDataForEdit(editId int, editIsVandalism bool) (*db.TrainingData, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("https://cluebotng-api.toolforge.org/?action=training.data&include_text=1&rev_id=%d", editId), nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("User-Agent", "ClueBot NG Review NG/1.0")
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error returned from API: %d", resp.StatusCode)
-	}
+type trainingImportStatus struct {
+	Started       bool              `json:"started"`
+	Progress      training.Progress `json:"progress"`
+	Failures      int               `json:"failures"`
+	FailuresDue   int               `json:"failures_due"`
+	NextRetryTime *time.Time        `json:"next_retry"`
+}
 
-	body, err := ioutil.ReadAll(resp.Body)
+func (app *App) calculateTrainingImportStatus(started bool) *trainingImportStatus {
+	failures, err := app.dbh.FetchAllTrainingDataFailures()
 	if err != nil {
-		return nil, err
-	}
-
-	trainingData := &db.TrainingData{}
-	if err := json.Unmarshal(body, &trainingData); err != nil {
-		return nil, err
+		panic(err)
 	}
-	trainingData.IsVandalism = editIsVandalism
 
-	if err := resp.Body.Close(); err != nil {
-		return nil, err
+	status := &trainingImportStatus{Started: started, Progress: app.trainingDownloader.Progress(), Failures: len(failures)}
+	now := time.Now()
+	for _, failure := range failures {
+		if !failure.NextRetry.After(now) {
+			status.FailuresDue++
+		} else if status.NextRetryTime == nil || failure.NextRetry.Before(*status.NextRetryTime) {
+			nextRetry := failure.NextRetry
+			status.NextRetryTime = &nextRetry
+		}
 	}
-
-	return trainingData, nil
+	return status
 }
 
-func (app *App) ApiTrainingImportHandler(w http.ResponseWriter, r *http.Request) {
-	app.trainingSync.Lock()
-	defer app.trainingSync.Unlock()
-
+// importTrainingData downloads training data for every reviewed edit without it
+func (app *App) importTrainingData() error {
 	// Find all edits that we should have data for
 	allEditGroups, err := app.dbh.FetchAllEditGroups()
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	editsRequiringTrainingData := map[int]bool{}
 	for _, editGroup := range allEditGroups {
 		editGroupEdits, err := app.dbh.LookupEditsByGroupId(editGroup.Id)
 		if err != nil {
-			panic(err)
+			return err
 		}
 
 		for _, e := range editGroupEdits {
@@ -90,28 +82,94 @@ func (app *App) ApiTrainingImportHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Find all edits we have training data for
-	allEditsWithTrainingData, err := app.dbh.GetTrainingDataEdits()
+	allEditsWithTrainingData, err := app.dbh.LookupEditIdsWithTrainingData()
 	if err != nil {
-		panic(err)
+		return err
+	}
+
+	// Stored data is refreshed once it is too old, or was fetched from an older API
+	fetchedBefore := time.Unix(0, 0)
+	if app.config.Training.RefreshAfterDays > 0 {
+		fetchedBefore = time.Now().AddDate(0, 0, -app.config.Training.RefreshAfterDays)
+	}
+	staleTrainingData, err := app.dbh.LookupStaleTrainingDataEditIds(fetchedBefore, app.config.Training.SchemaVersion)
+	if err != nil {
+		return err
+	}
+
+	// Deleted revisions are only downloaded again once an admin finds them available
+	unavailableEdits, err := app.dbh.LookupUnavailableEditIds()
+	if err != nil {
+		return err
 	}
 
 	editsMissingTrainingData := map[int]bool{}
 	for editId, isVandalism := range editsRequiringTrainingData {
-		if _, ok := allEditsWithTrainingData[editId]; !ok {
+		_, hasTrainingData := allEditsWithTrainingData[editId]
+		_, isStale := staleTrainingData[editId]
+		_, isUnavailable := unavailableEdits[editId]
+		if (!hasTrainingData || isStale) && !isUnavailable {
 			editsMissingTrainingData[editId] = isVandalism
 		}
 	}
 
-	// Cache training data for all missing edits
-	for editId, isVandalism := range editsMissingTrainingData {
-		if trainingData, err := downloadTrainingDataForEdit(editId, isVandalism); err != nil {
-			log.Printf("Failed to download training data: %v+: %+v", editId, err)
-		} else {
-			if err := app.dbh.StoreTrainingDataForEdit(editId, trainingData); err != nil {
-				log.Printf("Failed to store training data: %v+: %+v", editId, err)
-			} else {
-				log.Printf("Saved training data: %v+ (%+v)", editId, isVandalism)
-			}
-		}
+	if !app.trainingDownloader.Run(editsMissingTrainingData) {
+		return fmt.Errorf("training data download already in progress")
+	}
+	return nil
+}
+
+func (app *App) ApiTrainingImportHandler(w http.ResponseWriter, r *http.Request) {
+	// Downloads run as a background job, a run already in progress is left to finish
+	started := app.scheduler.Trigger(jobTrainingImport)
+
+	response, err := json.Marshal(app.calculateTrainingImportStatus(started))
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if started {
+		w.WriteHeader(http.StatusAccepted)
+	}
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) ApiTrainingImportStatusHandler(w http.ResponseWriter, r *http.Request) {
+	response, err := json.Marshal(app.calculateTrainingImportStatus(false))
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) ApiTrainingImportFailuresHandler(w http.ResponseWriter, r *http.Request) {
+	failures, err := app.dbh.FetchAllTrainingDataFailures()
+	if err != nil {
+		panic(err)
+	}
+
+	sortedFailures := []*db.TrainingDataFailure{}
+	for _, failure := range failures {
+		sortedFailures = append(sortedFailures, failure)
+	}
+	sort.Slice(sortedFailures, func(i, j int) bool {
+		return sortedFailures[i].EditId < sortedFailures[j].EditId
+	})
+
+	response, err := json.Marshal(sortedFailures)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
 	}
 }
@@ -153,3 +153,117 @@ func (app *App) ApiUserUpdateHandler(w http.ResponseWriter, r *http.Request) {
 		panic(err)
 	}
 }
+
+func (app *App) ApiUserSuspendPreviewHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	// Decode the request
+	suspendUserId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+	suspendUser, err := app.dbh.LookupUserById(suspendUserId)
+	if err != nil {
+		panic(err)
+	}
+	if suspendUser == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	preview, err := app.dbh.CalculateUserSuspensionImpact(suspendUser)
+	if err != nil {
+		panic(err)
+	}
+
+	response, err := json.Marshal(preview)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) ApiUserSuspendHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	// Decode the request
+	suspendUserId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+	suspendUser, err := app.dbh.LookupUserById(suspendUserId)
+	if err != nil {
+		panic(err)
+	}
+	if suspendUser == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	changes, err := app.dbh.SuspendUser(suspendUser.Id)
+	if err != nil {
+		panic(err)
+	}
+	app.queueSuspensionWebhookEvents(changes)
+}
+
+func (app *App) ApiUserUnsuspendHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, return an error
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Error(w, "Unauthorized", 401)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	// Decode the request
+	suspendUserId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		panic(err)
+	}
+	suspendUser, err := app.dbh.LookupUserById(suspendUserId)
+	if err != nil {
+		panic(err)
+	}
+	if suspendUser == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	changes, err := app.dbh.UnsuspendUser(suspendUser.Id)
+	if err != nil {
+		panic(err)
+	}
+	app.queueSuspensionWebhookEvents(changes)
+}
@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"github.com/cluebotng/reviewng/db"
 	"github.com/gorilla/mux"
+	"log"
 	"net/http"
 	"strconv"
 )
@@ -75,7 +76,7 @@ func (app *App) ApiUserClassificationCreateHandler(w http.ResponseWriter, r *htt
 	}
 
 	// Not approved, return an error
-	if !user.Approved || (app.config.App.AdminOnly && !user.Admin) {
+	if !user.Approved || user.Suspended || (app.config.App.AdminOnly && !user.Admin) {
 		http.Error(w, "Forbidden", 403)
 		return
 	}
@@ -120,6 +121,16 @@ func (app *App) ApiUserClassificationCreateHandler(w http.ResponseWriter, r *htt
 		}); err != nil {
 			panic(err)
 		}
+
+		change, err := app.dbh.RecordEditLabel(userClassification.EditId)
+		if err != nil {
+			panic(err)
+		}
+
+		// The review is stored, so a failure here is only logged
+		if err := app.queueReviewWebhookEvents(edit, change); err != nil {
+			log.Printf("Failed to queue webhook events for edit %d: %+v", userClassification.EditId, err)
+		}
 	}
 
 	response, err := json.Marshal(map[string]bool{"require_confirmation": requiresConfirmation})
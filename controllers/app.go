@@ -27,12 +27,14 @@ import (
 	"github.com/cluebotng/reviewng/cache"
 	"github.com/cluebotng/reviewng/cfg"
 	"github.com/cluebotng/reviewng/db"
+	"github.com/cluebotng/reviewng/jobs"
+	"github.com/cluebotng/reviewng/training"
+	"github.com/cluebotng/reviewng/webhooks"
 	"github.com/dghubble/oauth1"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/sessions"
 	"net/http"
 	"os"
-	"sync"
 	"time"
 )
 
@@ -54,27 +56,32 @@ func (nfs NoIndexFileSystem) Open(path string) (http.File, error) {
 }
 
 type App struct {
-	config       *cfg.Config
-	router       *mux.Router
-	sessionStore *sessions.CookieStore
-	cacheStore   *cache.InMemoryStorage
-	dbh          *db.Db
-	oauth        *oauth1.Config
-	fsTemplates  *embed.FS
-	fsStatic     *embed.FS
-	trainingSync sync.Mutex
+	config             *cfg.Config
+	router             *mux.Router
+	sessionStore       *sessions.CookieStore
+	cacheStore         *cache.InMemoryStorage
+	dbh                *db.Db
+	oauth              map[string]*oauth1.Config
+	fsTemplates        *embed.FS
+	fsStatic           *embed.FS
+	trainingDownloader *training.Downloader
+	scheduler          *jobs.Scheduler
+	webhookSender      *webhooks.Sender
 }
 
 func NewApp(cfg *cfg.Config, fsTemplates, fsStatic *embed.FS) *App {
-	oauth := oauth1.Config{
-		ConsumerKey:    cfg.OAuth.Token,
-		ConsumerSecret: cfg.OAuth.Secret,
-		CallbackURL:    "oob",
-		Endpoint: oauth1.Endpoint{
-			RequestTokenURL: "https://en.wikipedia.org/w/index.php?title=Special:OAuth/initiate",
-			AuthorizeURL:    "https://en.wikipedia.org/w/index.php?title=Special:OAuth/authorize",
-			AccessTokenURL:  "https://en.wikipedia.org/w/index.php?title=Special:OAuth/token",
-		},
+	oauth := map[string]*oauth1.Config{}
+	for _, wiki := range cfg.Wikis {
+		oauth[wiki.Name] = &oauth1.Config{
+			ConsumerKey:    cfg.OAuth.Token,
+			ConsumerSecret: cfg.OAuth.Secret,
+			CallbackURL:    "oob",
+			Endpoint: oauth1.Endpoint{
+				RequestTokenURL: wiki.OAuth.InitiateUrl,
+				AuthorizeURL:    wiki.OAuth.AuthorizeUrl,
+				AccessTokenURL:  wiki.OAuth.TokenUrl,
+			},
+		}
 	}
 
 	dbh, err := db.NewDb(cfg)
@@ -85,13 +92,19 @@ func NewApp(cfg *cfg.Config, fsTemplates, fsStatic *embed.FS) *App {
 	session := sessions.NewCookieStore([]byte(cfg.Session.SecretKey))
 	memoryCache := cache.NewInMemoryStorage()
 	app := App{
-		config:       cfg,
-		sessionStore: session,
-		cacheStore:   memoryCache,
-		dbh:          dbh,
-		oauth:        &oauth,
-		fsTemplates:  fsTemplates,
-		fsStatic:     fsStatic,
+		config:             cfg,
+		sessionStore:       session,
+		cacheStore:         memoryCache,
+		dbh:                dbh,
+		oauth:              oauth,
+		fsTemplates:        fsTemplates,
+		fsStatic:           fsStatic,
+		trainingDownloader: training.NewDownloader(cfg.Training, cfg.Wikis, dbh),
+		scheduler:          jobs.NewScheduler(dbh),
+		webhookSender:      webhooks.NewSender(time.Duration(cfg.Webhooks.Timeout) * time.Second),
+	}
+	if err := app.registerJobs(); err != nil {
+		panic(err)
 	}
 	return &app
 }
@@ -108,15 +121,20 @@ func (app *App) initializeRoutes() {
 	app.router.HandleFunc("/api/user", app.ApiUserCreateHandler).Methods("POST")
 	app.router.HandleFunc("/api/user/{id}", app.ApiUserGetHandler).Methods("GET")
 	app.router.HandleFunc("/api/user/{id}", app.ApiUserUpdateHandler).Methods("UPDATE")
+	app.router.HandleFunc("/api/user/{id}/suspend", app.ApiUserSuspendPreviewHandler).Methods("GET")
+	app.router.HandleFunc("/api/user/{id}/suspend", app.ApiUserSuspendHandler).Methods("POST")
+	app.router.HandleFunc("/api/user/{id}/suspend", app.ApiUserUnsuspendHandler).Methods("DELETE")
 
 	app.router.HandleFunc("/api/edit-group", app.ApiEditGroupListHandler).Methods("GET")
 	app.router.HandleFunc("/api/edit-group", app.ApiEditGroupCreateHandler).Methods("POST")
+	app.router.HandleFunc("/api/edit-group/split", app.ApiEditGroupSplitHandler).Methods("POST")
 	app.router.HandleFunc("/api/edit-group/{id}", app.ApiEditGroupGetHandler).Methods("GET")
 	app.router.HandleFunc("/api/edit-group/{id}", app.ApiEditGroupUpdateHandler).Methods("UPDATE")
 
 	app.router.HandleFunc("/api/edit", app.ApiEditListHandler).Methods("GET")
 	app.router.HandleFunc("/api/edit", app.ApiEditCreateHandler).Methods("POST")
 	app.router.HandleFunc("/api/edit/next", app.ApiEditNextHandler).Methods("GET")
+	app.router.HandleFunc("/api/edit/{id}/diff", app.ApiEditDiffHandler).Methods("GET")
 	app.router.HandleFunc("/api/edit/{id}", app.ApiEditGetHandler).Methods("GET")
 	app.router.HandleFunc("/api/edit/{id}", app.ApiEditUpdateHandler).Methods("UPDATE")
 
@@ -124,38 +142,82 @@ func (app *App) initializeRoutes() {
 	app.router.HandleFunc("/api/user-classification", app.ApiUserClassificationCreateHandler).Methods("POST")
 	app.router.HandleFunc("/api/user-classification/{id}", app.ApiUserClassificationGetHandler).Methods("GET")
 
+	app.router.HandleFunc("/api/qualification/next", app.ApiQualificationNextHandler).Methods("GET")
+	app.router.HandleFunc("/api/qualification/classification", app.ApiQualificationClassificationCreateHandler).Methods("POST")
+
 	app.router.HandleFunc("/api/cron/stats", app.ApiCronStatsHandler).Methods("GET")
 	app.router.HandleFunc("/api/report/import", app.ApiReportImportHandler).Methods("GET")
-	app.router.HandleFunc("/api/report/export", app.ApiReportExportHandler).Methods("GET")
+	app.router.HandleFunc("/api/report/import/runs", app.ApiReportImportRunsHandler).Methods("GET")
+	app.router.HandleFunc("/api/report/export", app.withConditionalRequest(app.ApiReportExportHandler)).Methods("GET")
+	app.router.HandleFunc("/api/report/v2/sync", app.withConditionalRequest(app.ApiReportSyncHandler)).Methods("GET")
 
 	app.router.HandleFunc("/api/training/import", app.ApiTrainingImportHandler).Methods("GET")
+	app.router.HandleFunc("/api/training/import/status", app.ApiTrainingImportStatusHandler).Methods("GET")
+	app.router.HandleFunc("/api/training/import/failures", app.ApiTrainingImportFailuresHandler).Methods("GET")
+	app.router.HandleFunc("/api/training/export.jsonl", app.withTrainingConditionalRequest(app.ApiTrainingExportHandler)).Methods("GET")
+	app.router.HandleFunc("/api/training/data/issues", app.ApiTrainingDataIssuesHandler).Methods("GET")
 	app.router.HandleFunc("/api/training/data/{id}", app.ApiTrainingDataHandler).Methods("GET")
+	app.router.HandleFunc("/api/training/data/{id}/history", app.ApiTrainingDataHistoryHandler).Methods("GET")
+	app.router.HandleFunc("/api/training/data/{id}/features", app.ApiTrainingDataFeaturesHandler).Methods("GET")
+
+	app.router.HandleFunc("/api/export/done", app.withConditionalRequest(app.ApiExportDoneHandler)).Methods("GET")
+	app.router.HandleFunc("/api/export/done.json", app.withConditionalRequest(app.ApiExportDoneJsonHandler)).Methods("GET")
+	app.router.HandleFunc("/api/export/dump", app.withConditionalRequest(app.ApiExportDumpHandler)).Methods("GET")
+	app.router.HandleFunc("/api/export/dump.json", app.withConditionalRequest(app.ApiExportDumpJsonHandler)).Methods("GET")
+	app.router.HandleFunc("/api/export/trainer.json", app.withConditionalRequest(app.ApiExportTrainerJsonHandler)).Methods("GET")
+	app.router.HandleFunc("/api/export/editset.xml", app.withTrainingConditionalRequest(app.ApiExportEditSetHandler)).Methods("GET")
+
+	app.router.HandleFunc("/api/dataset/snapshot", app.ApiDatasetSnapshotListHandler).Methods("GET")
+	app.router.HandleFunc("/api/dataset/snapshot", app.ApiDatasetSnapshotCreateHandler).Methods("POST")
+	app.router.HandleFunc("/api/dataset/snapshot/{id}", app.ApiDatasetSnapshotGetHandler).Methods("GET")
+	app.router.HandleFunc("/api/dataset/snapshot/{id}", app.ApiDatasetSnapshotDeleteHandler).Methods("DELETE")
+	app.router.HandleFunc("/api/dataset/snapshot/{id}/publish", app.ApiDatasetSnapshotPublishHandler).Methods("POST")
+	app.router.HandleFunc("/api/dataset/snapshot/{id}/manifest.sha256", app.ApiDatasetSnapshotManifestHandler).Methods("GET")
+	app.router.HandleFunc("/api/dataset/snapshot/{id}/{file}", app.ApiDatasetSnapshotFileHandler).Methods("GET")
+	app.router.HandleFunc("/api/dataset/diff", app.ApiDatasetDiffHandler).Methods("GET")
+	app.router.HandleFunc("/api/dataset/diff.csv", app.ApiDatasetDiffCsvHandler).Methods("GET")
 
-	app.router.HandleFunc("/api/export/done", app.ApiExportDoneHandler).Methods("GET")
-	app.router.HandleFunc("/api/export/done.json", app.ApiExportDoneJsonHandler).Methods("GET")
-	app.router.HandleFunc("/api/export/dump", app.ApiExportDumpHandler).Methods("GET")
-	app.router.HandleFunc("/api/export/dump.json", app.ApiExportDumpJsonHandler).Methods("GET")
-	app.router.HandleFunc("/api/export/trainer.json", app.ApiExportTrainerJsonHandler).Methods("GET")
 	app.router.HandleFunc("/api/config", app.ApiConfigHandler).Methods("GET")
+	app.router.HandleFunc("/api/me", app.ApiMeHandler).Methods("GET")
+	app.router.HandleFunc("/api/leaderboard", app.ApiLeaderboardHandler).Methods("GET")
 
 	app.router.HandleFunc("/", app.WelcomeHandler).Methods("GET")
 	app.router.HandleFunc("/review", app.ReviewHandler).Methods("GET")
+	app.router.HandleFunc("/me", app.MeHandler).Methods("GET")
+	app.router.HandleFunc("/qualification", app.QualificationHandler).Methods("GET")
 
 	app.router.HandleFunc("/admin", app.AdminHandler).Methods("GET")
 	app.router.HandleFunc("/admin/users", app.AdminUsersHandler).Methods("GET")
+	app.router.HandleFunc("/admin/users/{id}/suspend", app.AdminUserSuspendHandler).Methods("GET", "POST")
+	app.router.HandleFunc("/admin/users/{id}/approve", app.AdminUserApproveHandler).Methods("POST")
 	app.router.HandleFunc("/admin/edit-groups", app.AdminEditGroupsHandler).Methods("GET")
 	app.router.HandleFunc("/admin/edit-groups/{id}", app.AdminEditGroupDetailHandler).Methods("GET")
 	app.router.HandleFunc("/admin/details/{id}", app.AdminEditDetailsHandler).Methods("GET")
+	app.router.HandleFunc("/admin/edits/unavailable", app.AdminUnavailableEditsHandler).Methods("GET")
+	app.router.HandleFunc("/admin/edits/unavailable/{id}/recheck", app.AdminUnavailableEditRecheckHandler).Methods("POST")
+	app.router.HandleFunc("/admin/dataset/diff", app.AdminDatasetDiffHandler).Methods("GET")
+	app.router.HandleFunc("/admin/jobs", app.AdminJobsHandler).Methods("GET")
+	app.router.HandleFunc("/admin/jobs/{name}/run", app.AdminJobRunHandler).Methods("POST")
+	app.router.HandleFunc("/admin/training-data", app.AdminTrainingDataHandler).Methods("GET")
+	app.router.HandleFunc("/admin/webhooks", app.AdminWebhooksHandler).Methods("GET")
+	app.router.HandleFunc("/admin/webhooks", app.AdminWebhookCreateHandler).Methods("POST")
+	app.router.HandleFunc("/admin/webhooks/{id}/delete", app.AdminWebhookDeleteHandler).Methods("POST")
+	app.router.HandleFunc("/admin/webhooks/{id}/test", app.AdminWebhookTestHandler).Methods("POST")
+	app.router.HandleFunc("/admin/webhooks/deliveries/{id}/redeliver", app.AdminWebhookRedeliverHandler).Methods("POST")
+
+	app.router.Use(app.withRequestTimeout)
 }
 
 func (app *App) RunForever(addr string) {
 	app.initializeRoutes()
+	if app.config.JobsEnabled() {
+		app.scheduler.Start()
+	}
 	server := &http.Server{
-		Addr:         addr,
-		WriteTimeout: time.Second * 120,
-		ReadTimeout:  time.Second * 10,
-		IdleTimeout:  time.Second * 60,
-		Handler:      app.router,
+		Addr:        addr,
+		ReadTimeout: time.Second * 10,
+		IdleTimeout: time.Second * 60,
+		Handler:     app.router,
 	}
 	if err := server.ListenAndServe(); err != nil {
 		panic(err)
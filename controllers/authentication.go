@@ -30,16 +30,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/cluebotng/reviewng/db"
+	"github.com/cluebotng/reviewng/webhooks"
 	"github.com/dghubble/oauth1"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"strings"
 	"time"
 )
 
 func (app *App) LoginHandler(w http.ResponseWriter, r *http.Request) {
+	// Select the wiki to authenticate against
+	wiki := app.config.DefaultWiki()
+	if name := r.URL.Query().Get("wiki"); name != "" {
+		if wiki = app.config.LookupWiki(name); wiki == nil {
+			http.Error(w, "Unknown Wiki", 400)
+			return
+		}
+	}
+	oauth := app.oauth[wiki.Name]
+
 	// Redirect to the login page
-	requestToken, requestSecret, err := app.oauth.RequestToken()
+	requestToken, requestSecret, err := oauth.RequestToken()
 	if err != nil {
 		panic(err)
 	}
@@ -47,11 +59,12 @@ func (app *App) LoginHandler(w http.ResponseWriter, r *http.Request) {
 	// Store the random secret for this handshake
 	session := app.getSessionStore(r)
 	session.Values["oauth.request-secret"] = requestSecret
+	session.Values["wiki"] = wiki.Name
 	if err := session.Save(r, w); err != nil {
 		panic(err)
 	}
 
-	authorizationURL, err := app.oauth.AuthorizationURL(requestToken)
+	authorizationURL, err := oauth.AuthorizationURL(requestToken)
 	if err != nil {
 		panic(err)
 	}
@@ -102,6 +115,8 @@ func (app *App) LoginCallbackHandler(w http.ResponseWriter, r *http.Request) {
 	requestToken := r.URL.Query().Get("oauth_token")
 	verifier := r.URL.Query().Get("oauth_verifier")
 	session := app.getSessionStore(r)
+	wiki := app.getSessionWiki(r)
+	oauth := app.oauth[wiki.Name]
 
 	// Get the secret from storage
 	requestSecret := ""
@@ -110,15 +125,15 @@ func (app *App) LoginCallbackHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get an access token using the data passed + our initial secret
-	accessToken, accessSecret, err := app.oauth.AccessToken(requestToken, requestSecret, verifier)
+	accessToken, accessSecret, err := oauth.AccessToken(requestToken, requestSecret, verifier)
 	if err != nil {
 		panic(err)
 	}
 
 	// Using the access token fetch the identity
 	token := oauth1.NewToken(accessToken, accessSecret)
-	httpClient := app.oauth.Client(context.Background(), token)
-	req, err := http.NewRequest("GET", "https://en.wikipedia.org/w/index.php?title=Special:OAuth/identify", nil)
+	httpClient := oauth.Client(context.Background(), token)
+	req, err := http.NewRequest("GET", wiki.OAuth.IdentifyUrl, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -140,11 +155,11 @@ func (app *App) LoginCallbackHandler(w http.ResponseWriter, r *http.Request) {
 	jwtPayload := decodeJwt(body, app.config.OAuth.Secret)
 
 	// Check the payload data
-	if jwtPayload["iss"] != "https://en.wikipedia.org" {
+	if jwtPayload["iss"] != wiki.OAuth.Issuer {
 		panic(fmt.Sprintf("Invalid issuer: %+v", jwtPayload["iss"]))
 	}
 
-	if jwtPayload["aud"] != app.oauth.ConsumerKey {
+	if jwtPayload["aud"] != oauth.ConsumerKey {
 		panic(fmt.Sprintf("Invalid audience: %+v", jwtPayload["aud"]))
 	}
 
@@ -178,6 +193,11 @@ func (app *App) LoginCallbackHandler(w http.ResponseWriter, r *http.Request) {
 		if err != nil {
 			panic(err)
 		}
+
+		// New users are pending approval by an admin
+		if err := app.queueWebhookEvent(webhooks.EventUserSignup, map[string]interface{}{"user": user.Username, "approved": user.Approved}); err != nil {
+			log.Printf("Failed to queue webhook event for user %s: %+v", user.Username, err)
+		}
 	}
 
 	if err := app.setAuthenticatedUser(r, w, user); err != nil {
@@ -0,0 +1,182 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"compress/gzip"
+	"fmt"
+	"github.com/cluebotng/reviewng/cfg"
+	"github.com/cluebotng/reviewng/export"
+	"github.com/klauspost/compress/zstd"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// compressingWriter is implemented by both the gzip and zstd writers
+type compressingWriter interface {
+	Write(p []byte) (int, error)
+	Flush() error
+	Close() error
+}
+
+// compressedResponseWriter compresses the body, flushing through so streamed exports are still sent group by group
+type compressedResponseWriter struct {
+	http.ResponseWriter
+	writer compressingWriter
+}
+
+func (c *compressedResponseWriter) Write(p []byte) (int, error) {
+	// Sniff the uncompressed body, the server would otherwise detect the compressed bytes
+	if c.Header().Get("Content-Type") == "" {
+		c.Header().Set("Content-Type", http.DetectContentType(p))
+	}
+	return c.writer.Write(p)
+}
+
+func (c *compressedResponseWriter) Flush() {
+	if err := c.writer.Flush(); err != nil {
+		return
+	}
+	if f, ok := c.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// newCompressingWriter returns the writer for the preferred encoding the client accepts, zstd over gzip
+func newCompressingWriter(w http.ResponseWriter, r *http.Request) (string, compressingWriter) {
+	if acceptsEncoding(r, "zstd") {
+		// The window is limited to 8MB, the most browsers are required to support for zstd content encoding
+		if writer, err := zstd.NewWriter(w, zstd.WithWindowSize(8<<20)); err == nil {
+			return "zstd", writer
+		}
+	}
+	if acceptsEncoding(r, "gzip") {
+		return "gzip", gzip.NewWriter(w)
+	}
+	return "", nil
+}
+
+// acceptsEncoding reports if the encoding is listed in Accept-Encoding without a zero quality
+func acceptsEncoding(r *http.Request, encoding string) bool {
+	for _, value := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		parts := strings.Split(value, ";")
+		if strings.TrimSpace(parts[0]) != encoding {
+			continue
+		}
+		for _, param := range parts[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(param[2:], 64); err == nil && q == 0 {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// etagMatches uses the weak comparison, as the same version is served both compressed and not
+func etagMatches(header, etag string) bool {
+	for _, value := range strings.Split(header, ",") {
+		value = strings.TrimSpace(value)
+		if value == "*" || strings.TrimPrefix(value, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// requestVersion returns the ETag and Last-Modified for the request, training data is only versioned in when it is exported
+func (app *App) requestVersion(r *http.Request, withTrainingData bool) (string, time.Time) {
+	version, err := app.dbh.LookupDataVersion()
+	if err != nil {
+		panic(err)
+	}
+
+	// The release is included as the output format may change between deploys
+	etag := fmt.Sprintf("W/\"%s-%d\"", cfg.ReleaseTag, version.Version)
+	lastModified := version.Updated
+
+	if format := export.LookupFormat(r.URL.Query().Get("format")); format != nil && format.NeedsTrainingData {
+		withTrainingData = true
+	}
+	if withTrainingData {
+		trainingDataVersion, err := app.dbh.LookupTrainingDataVersion()
+		if err != nil {
+			panic(err)
+		}
+		etag = fmt.Sprintf("W/\"%s-%d-%d\"", cfg.ReleaseTag, version.Version, trainingDataVersion.Version)
+		if trainingDataVersion.Updated.After(lastModified) {
+			lastModified = trainingDataVersion.Updated
+		}
+	}
+	return etag, lastModified.UTC().Truncate(time.Second)
+}
+
+// withConditionalRequest adds validators based on the data version, answering with a 304 when the client is current
+func (app *App) withConditionalRequest(next http.HandlerFunc) http.HandlerFunc {
+	return app.withVersionedRequest(next, false)
+}
+
+// withTrainingConditionalRequest is withConditionalRequest for exports which always include training data
+func (app *App) withTrainingConditionalRequest(next http.HandlerFunc) http.HandlerFunc {
+	return app.withVersionedRequest(next, true)
+}
+
+func (app *App) withVersionedRequest(next http.HandlerFunc, withTrainingData bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		etag, lastModified := app.requestVersion(r, withTrainingData)
+
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		// If-None-Match takes precedence when both are sent
+		if value := r.Header.Get("If-None-Match"); value != "" {
+			if etagMatches(value, etag) {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		} else if value := r.Header.Get("If-Modified-Since"); value != "" {
+			if since, err := http.ParseTime(value); err == nil && !lastModified.After(since) {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+
+		encoding, writer := newCompressingWriter(w, r)
+		if writer == nil {
+			next(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Encoding", encoding)
+		cw := &compressedResponseWriter{ResponseWriter: w, writer: writer}
+		defer cw.writer.Close()
+		next(cw, r)
+	}
+}
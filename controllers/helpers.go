@@ -23,6 +23,7 @@ package controllers
 // SOFTWARE.
 
 import (
+	"github.com/cluebotng/reviewng/cfg"
 	"github.com/cluebotng/reviewng/db"
 	"github.com/gorilla/sessions"
 	"net/http"
@@ -49,6 +50,16 @@ func (app *App) setAuthenticatedUser(r *http.Request, w http.ResponseWriter, use
 	return session.Save(r, w)
 }
 
+func (app *App) getSessionWiki(r *http.Request) *cfg.WikiConfig {
+	session := app.getSessionStore(r)
+	if name, ok := session.Values["wiki"]; ok {
+		if wiki := app.config.LookupWiki(name.(string)); wiki != nil {
+			return wiki
+		}
+	}
+	return app.config.DefaultWiki()
+}
+
 func (app *App) clearSessionData(r *http.Request, w http.ResponseWriter) error {
 	session := app.getSessionStore(r)
 	session.Values = map[interface{}]interface{}{}
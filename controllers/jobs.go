@@ -0,0 +1,139 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/json"
+	"github.com/cluebotng/reviewng/db"
+	"github.com/gorilla/mux"
+	"html/template"
+	"net/http"
+)
+
+const (
+	jobStats              = "stats"
+	jobReportImport       = "report-import"
+	jobTrainingImport     = "training-import"
+	jobTrainingRevalidate = "training-revalidate"
+	jobWebhookDeliver     = "webhook-deliver"
+)
+
+func (app *App) registerJobs() error {
+	jobFuncs := map[string]func() error{
+		jobStats:              app.publishStats,
+		jobReportImport:       app.importReportEdits,
+		jobTrainingImport:     app.importTrainingData,
+		jobTrainingRevalidate: app.revalidateTrainingData,
+		jobWebhookDeliver:     app.deliverWebhooks,
+	}
+	for name, run := range jobFuncs {
+		if err := app.scheduler.Register(name, app.config.Jobs.Schedules[name], run); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeJobTriggered starts the job in the background, for the endpoints previously called by external cron jobs
+func (app *App) writeJobTriggered(w http.ResponseWriter, name string) {
+	started := app.scheduler.Trigger(name)
+
+	response, err := json.Marshal(map[string]interface{}{"job": name, "started": started})
+	if err != nil {
+		panic(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if started {
+		w.WriteHeader(http.StatusAccepted)
+	}
+	if _, err := w.Write(response); err != nil {
+		panic(err)
+	}
+}
+
+type jobSummary struct {
+	Name     string
+	Schedule string
+	Running  bool
+}
+
+func (app *App) AdminJobsHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	jobs := []jobSummary{}
+	for _, name := range app.scheduler.JobNames() {
+		jobs = append(jobs, jobSummary{
+			Name:     name,
+			Schedule: app.config.Jobs.Schedules[name],
+			Running:  app.scheduler.IsRunning(name),
+		})
+	}
+
+	runs, err := app.dbh.LookupRecentJobRuns(50)
+	if err != nil {
+		panic(err)
+	}
+
+	t, err := template.ParseFS(app.fsTemplates, "templates/admin/jobs.tmpl")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := t.Execute(w, struct {
+		Enabled bool
+		Jobs    []jobSummary
+		Runs    []*db.JobRun
+	}{app.config.JobsEnabled(), jobs, runs}); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) AdminJobRunHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Not an admin, return an error
+	if !user.Admin {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	app.scheduler.Trigger(mux.Vars(r)["name"])
+	http.Redirect(w, r, "/admin/jobs", http.StatusFound)
+}
@@ -0,0 +1,69 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"github.com/cluebotng/reviewng/db"
+	"html/template"
+	"net/http"
+)
+
+func (app *App) MeHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	dashboard := calculateUserDashboard(app, user)
+
+	// Scale the activity chart against the busiest day
+	maxDailyActivity := 0
+	for _, day := range dashboard.DailyActivity {
+		maxDailyActivity = db.MaxInt(maxDailyActivity, day.Count)
+	}
+
+	t, err := template.New("me.tmpl").Funcs(template.FuncMap{
+		"classificationToHuman": ConvertClassificationToHumanString,
+		"barHeight": func(count int) int {
+			if maxDailyActivity == 0 {
+				return 0
+			}
+			return (count * 100) / maxDailyActivity
+		},
+	}).ParseFS(app.fsTemplates, "templates/me.tmpl")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := t.Execute(w, struct {
+		Dashboard userDashboard
+		IndexUrl  string
+	}{
+		Dashboard: dashboard,
+		IndexUrl:  app.getSessionWiki(r).IndexUrl,
+	}); err != nil {
+		panic(err)
+	}
+}
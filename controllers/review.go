@@ -37,7 +37,7 @@ func (app *App) ReviewHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Not approved, return an error
-	if !user.Approved || (app.config.App.AdminOnly && !user.Admin) {
+	if !user.Approved || user.Suspended || (app.config.App.AdminOnly && !user.Admin) {
 		http.Error(w, "Forbidden", 403)
 		return
 	}
@@ -48,7 +48,38 @@ func (app *App) ReviewHandler(w http.ResponseWriter, r *http.Request) {
 		panic(err)
 	}
 
-	if err := t.Execute(w, struct{ User *db.User }{User: user}); err != nil {
+	if err := t.Execute(w, struct {
+		User     *db.User
+		Practice bool
+	}{User: user}); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) QualificationHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
+	if user == nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Already approved or not running qualifications, return an error
+	if !app.canTakeQualification(user) {
+		http.Error(w, "Forbidden", 403)
+		return
+	}
+
+	// The practice queue uses the review page against the qualification endpoints
+	t, err := template.ParseFS(app.fsTemplates, "templates/review.tmpl")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := t.Execute(w, struct {
+		User     *db.User
+		Practice bool
+	}{User: user, Practice: true}); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,61 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"github.com/gorilla/mux"
+	"net/http"
+	"time"
+)
+
+// requestTimeout bounds the non-streaming handlers, the server itself has no write deadline
+const requestTimeout = time.Second * 120
+
+// streamingRoutes write their response as it is generated and may run longer than requestTimeout
+var streamingRoutes = map[string]bool{
+	"/api/report/export":                true,
+	"/api/report/v2/sync":               true,
+	"/api/training/export.jsonl":        true,
+	"/api/export/done":                  true,
+	"/api/export/done.json":             true,
+	"/api/export/dump":                  true,
+	"/api/export/dump.json":             true,
+	"/api/export/trainer.json":          true,
+	"/api/export/editset.xml":           true,
+	"/api/dataset/snapshot/{id}/{file}": true,
+	"/api/dataset/diff.csv":             true,
+}
+
+// withRequestTimeout applies requestTimeout to every route except the streaming exports
+func (app *App) withRequestTimeout(next http.Handler) http.Handler {
+	timeout := http.TimeoutHandler(next, requestTimeout, "Request timed out")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route := mux.CurrentRoute(r); route != nil {
+			if path, err := route.GetPathTemplate(); err == nil && streamingRoutes[path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+		timeout.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,239 @@
+package controllers
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/json"
+	"github.com/cluebotng/reviewng/db"
+	"github.com/cluebotng/reviewng/webhooks"
+	"log"
+	"time"
+)
+
+type webhookPayload struct {
+	Event   string      `json:"event"`
+	Created time.Time   `json:"created"`
+	Data    interface{} `json:"data"`
+}
+
+type webhookEditData struct {
+	Edit     *reportSyncEdit `json:"edit"`
+	OldLabel string          `json:"old_label,omitempty"`
+	NewLabel string          `json:"new_label,omitempty"`
+}
+
+type webhookEditGroupData struct {
+	Id    int    `json:"id"`
+	Name  string `json:"name"`
+	Wiki  string `json:"wiki"`
+	Edits int    `json:"edits"`
+}
+
+func newWebhookPayload(event string, data interface{}) (string, error) {
+	payload, err := json.Marshal(webhookPayload{Event: event, Created: time.Now().UTC(), Data: data})
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+// queueWebhookEvent creates a delivery for each subscribed webhook, starting delivery straight away
+func (app *App) queueWebhookEvent(event string, data interface{}) error {
+	payload, err := newWebhookPayload(event, data)
+	if err != nil {
+		return err
+	}
+
+	queued, err := app.dbh.CreateWebhookDeliveries(event, payload)
+	if err != nil {
+		return err
+	}
+	if queued > 0 {
+		app.scheduler.Trigger(jobWebhookDeliver)
+	}
+	return nil
+}
+
+// queueReviewWebhookEvents compares the edit before and after a review, change is the recorded label change (if any)
+func (app *App) queueReviewWebhookEvents(before *db.Edit, change *db.EditLabelChange) error {
+	after, err := app.dbh.LookupEditById(before.Id)
+	if err != nil {
+		return err
+	}
+	if after == nil {
+		return nil
+	}
+
+	beforeStatus, afterStatus := reportSyncStatus(before), reportSyncStatus(after)
+	data := webhookEditData{Edit: newReportSyncEdit(after, nil)}
+
+	if change != nil {
+		labelData := data
+		labelData.OldLabel = reportSyncClassification(change.OldClassification)
+		labelData.NewLabel = reportSyncClassification(change.NewClassification)
+		if err := app.queueWebhookEvent(webhooks.EventEditLabelChanged, labelData); err != nil {
+			return err
+		}
+	}
+
+	if afterStatus == "contested" && beforeStatus != "contested" {
+		if err := app.queueWebhookEvent(webhooks.EventEditContested, data); err != nil {
+			return err
+		}
+	}
+
+	if afterStatus != "done" || beforeStatus == "done" {
+		return nil
+	}
+	if err := app.queueWebhookEvent(webhooks.EventEditConsensus, data); err != nil {
+		return err
+	}
+	return app.queueEditGroupCompletedWebhookEvents(after.Id, map[int]bool{})
+}
+
+// queueSuspensionWebhookEvents sends the events for labels changed by (un)quarantining a user's reviews.
+// Only the labels are known before the change, so contested is sent for edits which lost their consensus.
+func (app *App) queueSuspensionWebhookEvents(changes []*db.EditLabelChange) {
+	// Several edits of a group may reach consensus together, the group is only reported once
+	completedEditGroupIds := map[int]bool{}
+	for _, change := range changes {
+		if err := app.queueLabelChangeWebhookEvents(change, completedEditGroupIds); err != nil {
+			log.Printf("Failed to queue webhook events for edit %d: %+v", change.EditId, err)
+		}
+	}
+}
+
+func (app *App) queueLabelChangeWebhookEvents(change *db.EditLabelChange, completedEditGroupIds map[int]bool) error {
+	edit, err := app.dbh.LookupEditById(change.EditId)
+	if err != nil {
+		return err
+	}
+	if edit == nil {
+		return nil
+	}
+
+	data := webhookEditData{Edit: newReportSyncEdit(edit, nil)}
+	labelData := data
+	labelData.OldLabel = reportSyncClassification(change.OldClassification)
+	labelData.NewLabel = reportSyncClassification(change.NewClassification)
+	if err := app.queueWebhookEvent(webhooks.EventEditLabelChanged, labelData); err != nil {
+		return err
+	}
+
+	if change.NewClassification == db.EDIT_CLASSIFICATION_UNKNOWN {
+		if reportSyncStatus(edit) == "contested" {
+			return app.queueWebhookEvent(webhooks.EventEditContested, data)
+		}
+		return nil
+	}
+	if change.OldClassification != db.EDIT_CLASSIFICATION_UNKNOWN {
+		return nil
+	}
+	if err := app.queueWebhookEvent(webhooks.EventEditConsensus, data); err != nil {
+		return err
+	}
+	return app.queueEditGroupCompletedWebhookEvents(edit.Id, completedEditGroupIds)
+}
+
+// queueEditGroupCompletedWebhookEvents sends an event for each group of the edit where every edit has now reached consensus,
+// skipping the groups already reported
+func (app *App) queueEditGroupCompletedWebhookEvents(editId int, completedEditGroupIds map[int]bool) error {
+	editGroupIds, err := app.dbh.LookupEditGroupIdsByEditId(editId)
+	if err != nil {
+		return err
+	}
+
+	for _, editGroupId := range editGroupIds {
+		if completedEditGroupIds[editGroupId] {
+			continue
+		}
+
+		edits, err := app.dbh.LookupEditsByGroupId(editGroupId)
+		if err != nil {
+			return err
+		}
+
+		completed := true
+		for _, edit := range edits {
+			if edit.ReviewedClassification() == db.EDIT_CLASSIFICATION_UNKNOWN {
+				completed = false
+				break
+			}
+		}
+		if !completed {
+			continue
+		}
+		completedEditGroupIds[editGroupId] = true
+
+		editGroup, err := app.dbh.LookupEditGroupById(editGroupId)
+		if err != nil {
+			return err
+		}
+		if editGroup == nil {
+			continue
+		}
+
+		if err := app.queueWebhookEvent(webhooks.EventEditGroupCompleted, webhookEditGroupData{
+			Id:    editGroup.Id,
+			Name:  editGroup.Name,
+			Wiki:  editGroup.Wiki,
+			Edits: len(edits),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliverWebhooks sends the due deliveries, failed attempts are retried with backoff until the configured attempts are used
+func (app *App) deliverWebhooks() error {
+	maxBackoff := time.Duration(app.config.Webhooks.MaxBackoff) * time.Second
+	for {
+		deliveries, err := app.dbh.LookupDueWebhookDeliveries(100)
+		if err != nil {
+			return err
+		}
+
+		for _, delivery := range deliveries {
+			status, retryIn := db.WEBHOOK_DELIVERY_DELIVERED, time.Duration(0)
+			deliveryError := ""
+
+			responseCode, err := app.webhookSender.Send(delivery.Url, delivery.Secret, delivery.Event, delivery.Id, []byte(delivery.Payload))
+			if err != nil {
+				deliveryError = err.Error()
+				status = db.WEBHOOK_DELIVERY_FAILED
+				if delivery.Attempts+1 < app.config.Webhooks.Attempts {
+					status, retryIn = db.WEBHOOK_DELIVERY_PENDING, webhooks.Backoff(delivery.Attempts+1, maxBackoff)
+				}
+			}
+
+			if err := app.dbh.RecordWebhookDeliveryAttempt(delivery.Id, status, responseCode, deliveryError, retryIn); err != nil {
+				return err
+			}
+		}
+
+		if len(deliveries) < 100 {
+			return nil
+		}
+	}
+}
@@ -23,53 +23,45 @@ package controllers
 // SOFTWARE.
 
 import (
+	"github.com/cluebotng/reviewng/cfg"
 	"github.com/cluebotng/reviewng/db"
 	"html/template"
 	"net/http"
 )
 
-type userContributions struct {
-	Username string
-	Count    int
-}
+func (app *App) WelcomeHandler(w http.ResponseWriter, r *http.Request) {
+	// Not logged in, send to the login page
+	user := app.getAuthenticatedUser(r)
 
-func calculateContributionStats(app *App) []userContributions {
-	allUsers, err := app.dbh.FetchAllUsers()
+	t, err := template.ParseFS(app.fsTemplates, "templates/welcome.tmpl")
 	if err != nil {
 		panic(err)
 	}
 
-	contributions := []userContributions{}
-	for _, user := range allUsers {
-		userClassifications, err := app.dbh.CalculateTotalUserClassifications(user)
+	var qualification *db.UserQualification
+	canQualify := false
+	if user != nil && app.canTakeQualification(user) {
+		canQualify = true
+		qualification, err = app.dbh.LookupUserQualification(user.Id)
 		if err != nil {
 			panic(err)
 		}
-		if userClassifications > 0 {
-			contributions = append(contributions, userContributions{user.Username, userClassifications})
-		}
-	}
-	return contributions
-}
-
-func (app *App) WelcomeHandler(w http.ResponseWriter, r *http.Request) {
-	// Not logged in, send to the login page
-	user := app.getAuthenticatedUser(r)
-
-	t, err := template.ParseFS(app.fsTemplates, "templates/welcome.tmpl")
-	if err != nil {
-		panic(err)
 	}
 
-	contributionStats := calculateContributionStats(app)
 	if err := t.Execute(w, struct {
-		User              *db.User
-		ContributionStats []userContributions
-		AdminOnly         bool
+		User          *db.User
+		Leaderboards  leaderboards
+		AdminOnly     bool
+		Wikis         []cfg.WikiConfig
+		CanQualify    bool
+		Qualification *db.UserQualification
 	}{
-		User:              user,
-		ContributionStats: contributionStats,
-		AdminOnly:         app.config.App.AdminOnly,
+		User:          user,
+		Leaderboards:  calculateLeaderboards(app, 0),
+		AdminOnly:     app.config.App.AdminOnly,
+		Wikis:         app.config.Wikis,
+		CanQualify:    canQualify,
+		Qualification: qualification,
 	}); err != nil {
 		panic(err)
 	}
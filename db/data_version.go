@@ -0,0 +1,82 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"time"
+)
+
+// DataVersion is bumped whenever data included in the exports changes
+type DataVersion struct {
+	Version int64
+	Updated time.Time
+}
+
+// Votes, edits and groups are versioned separately from training data, which changes constantly while downloading
+const dataVersionClassifications = 1
+const dataVersionTrainingData = 2
+
+func (db *Db) lookupDataVersion(id int) (*DataVersion, error) {
+	results, err := db.db.Query("SELECT version, updated FROM data_version WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nothing has changed since the schema was loaded
+	version := &DataVersion{Updated: time.Unix(0, 0).UTC()}
+	if results.Next() {
+		if err := results.Scan(&version.Version, &version.Updated); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+func (db *Db) incrementDataVersion(id int) error {
+	if _, err := db.db.Exec("INSERT INTO data_version (id, version, updated) VALUES (?, 1, NOW()) ON DUPLICATE KEY UPDATE version = version + 1, updated = NOW()", id); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (db *Db) LookupDataVersion() (*DataVersion, error) {
+	return db.lookupDataVersion(dataVersionClassifications)
+}
+
+func (db *Db) IncrementDataVersion() error {
+	return db.incrementDataVersion(dataVersionClassifications)
+}
+
+// LookupTrainingDataVersion is bumped when stored training data is added, replaced or changes validation status
+func (db *Db) LookupTrainingDataVersion() (*DataVersion, error) {
+	return db.lookupDataVersion(dataVersionTrainingData)
+}
+
+func (db *Db) IncrementTrainingDataVersion() error {
+	return db.incrementDataVersion(dataVersionTrainingData)
+}
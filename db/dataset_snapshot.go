@@ -0,0 +1,352 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+type DatasetSnapshot struct {
+	Id          int        `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Status      int        `json:"status"`
+	Entries     int        `json:"entries"`
+	Created     time.Time  `json:"created"`
+	Published   *time.Time `json:"published"`
+}
+
+// DatasetSnapshotEntry is the label of an edit in a group, frozen at the time of the snapshot
+type DatasetSnapshotEntry struct {
+	EditGroupId    int     `json:"edit_group_id"`
+	EditId         int     `json:"edit_id"`
+	Classification int     `json:"classification"`
+	Confidence     float32 `json:"confidence"`
+	Vandalism      int     `json:"vandalism"`
+	Constructive   int     `json:"constructive"`
+	Skipped        int     `json:"skipped"`
+}
+
+// DatasetSnapshotFile is a download of a published snapshot, the content is stored so it never changes
+type DatasetSnapshotFile struct {
+	Name    string `json:"name"`
+	Sha256  string `json:"sha256"`
+	Size    int64  `json:"size"`
+	Content []byte `json:"-"`
+}
+
+// NewDatasetSnapshotEntry calculates the entry for the current votes on an edit
+func NewDatasetSnapshotEntry(editGroupId int, edit *Edit) *DatasetSnapshotEntry {
+	entry := &DatasetSnapshotEntry{
+		EditGroupId:    editGroupId,
+		EditId:         edit.Id,
+		Classification: edit.ReviewedClassification(),
+		Vandalism:      edit.UserClassificationsVandalism,
+		Constructive:   edit.UserClassificationsConstructive,
+		Skipped:        edit.UserClassificationsSkipped,
+	}
+
+	// Confidence is the share of votes in agreement with the label
+	total := entry.Vandalism + entry.Constructive + entry.Skipped
+	if total > 0 {
+		switch entry.Classification {
+		case EDIT_CLASSIFICATION_VANDALISM:
+			entry.Confidence = float32(entry.Vandalism) / float32(total)
+		case EDIT_CLASSIFICATION_CONSTRUCTIVE:
+			entry.Confidence = float32(entry.Constructive) / float32(total)
+		case EDIT_CLASSIFICATION_SKIPPED:
+			entry.Confidence = float32(entry.Skipped) / float32(total)
+		}
+	}
+	return entry
+}
+
+// CalculateLiveDatasetEntries returns the labelled edits of the groups as they would be snapshotted now
+func (db *Db) CalculateLiveDatasetEntries(editGroupIds []int) ([]*DatasetSnapshotEntry, error) {
+	unavailableEdits, err := db.LookupUnavailableEditIds()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*DatasetSnapshotEntry{}
+	for _, editGroupId := range editGroupIds {
+		edits, err := db.LookupEditsByGroupId(editGroupId)
+		if err != nil {
+			return nil, err
+		}
+		for _, edit := range edits {
+			if _, ok := unavailableEdits[edit.Id]; ok {
+				continue
+			}
+			if edit.ReviewedClassification() != EDIT_CLASSIFICATION_UNKNOWN {
+				entries = append(entries, NewDatasetSnapshotEntry(editGroupId, edit))
+			}
+		}
+	}
+	return entries, nil
+}
+
+func scanDatasetSnapshot(results *sql.Rows) (*DatasetSnapshot, error) {
+	snapshot := &DatasetSnapshot{}
+	var published sql.NullTime
+	if err := results.Scan(&snapshot.Id, &snapshot.Name, &snapshot.Description, &snapshot.Status, &snapshot.Entries, &snapshot.Created, &published); err != nil {
+		return nil, err
+	}
+	if published.Valid {
+		snapshot.Published = &published.Time
+	}
+	return snapshot, nil
+}
+
+func (db *Db) LookupDatasetSnapshotById(id int) (*DatasetSnapshot, error) {
+	results, err := db.db.Query("SELECT id, name, description, status, entries, created, published FROM dataset_snapshot WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !results.Next() {
+		return nil, nil
+	}
+
+	snapshot, err := scanDatasetSnapshot(results)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func (db *Db) FetchAllDatasetSnapshots() ([]*DatasetSnapshot, error) {
+	results, err := db.db.Query("SELECT id, name, description, status, entries, created, published FROM dataset_snapshot ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []*DatasetSnapshot{}
+	for results.Next() {
+		snapshot, err := scanDatasetSnapshot(results)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// CreateDatasetSnapshot freezes the current labels of the groups into a new draft snapshot
+func (db *Db) CreateDatasetSnapshot(name, description string, editGroupIds []int) (*DatasetSnapshot, error) {
+	entries, err := db.CalculateLiveDatasetEntries(editGroupIds)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, "INSERT INTO dataset_snapshot (name, description, status, entries) VALUES (?, ?, ?, ?)", name, description, DATASET_SNAPSHOT_DRAFT, len(entries))
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO dataset_snapshot_entry (snapshot_id, edit_group_id, edit_id, classification, confidence, vandalism, constructive, skipped) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+			id, entry.EditGroupId, entry.EditId, entry.Classification, entry.Confidence, entry.Vandalism, entry.Constructive, entry.Skipped); err != nil {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return db.LookupDatasetSnapshotById(int(id))
+}
+
+func (db *Db) LookupDatasetSnapshotEntries(id int) ([]*DatasetSnapshotEntry, error) {
+	results, err := db.db.Query("SELECT edit_group_id, edit_id, classification, confidence, vandalism, constructive, skipped FROM dataset_snapshot_entry WHERE snapshot_id = ? ORDER BY edit_group_id, edit_id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*DatasetSnapshotEntry{}
+	for results.Next() {
+		entry := &DatasetSnapshotEntry{}
+		if err := results.Scan(&entry.EditGroupId, &entry.EditId, &entry.Classification, &entry.Confidence, &entry.Vandalism, &entry.Constructive, &entry.Skipped); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (db *Db) LookupDatasetSnapshotFiles(id int) ([]*DatasetSnapshotFile, error) {
+	results, err := db.db.Query("SELECT name, sha256, size FROM dataset_snapshot_file WHERE snapshot_id = ? ORDER BY name", id)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []*DatasetSnapshotFile{}
+	for results.Next() {
+		file := &DatasetSnapshotFile{}
+		if err := results.Scan(&file.Name, &file.Sha256, &file.Size); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// LookupDatasetSnapshotFile returns a published file including its content
+func (db *Db) LookupDatasetSnapshotFile(id int, name string) (*DatasetSnapshotFile, error) {
+	results, err := db.db.Query("SELECT name, sha256, size, content FROM dataset_snapshot_file WHERE snapshot_id = ? AND name = ?", id, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !results.Next() {
+		return nil, nil
+	}
+
+	file := &DatasetSnapshotFile{}
+	if err := results.Scan(&file.Name, &file.Sha256, &file.Size, &file.Content); err != nil {
+		return nil, err
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// PublishDatasetSnapshot records the manifest and marks the snapshot immutable, returning false if it was not a draft
+func (db *Db) PublishDatasetSnapshot(id int, files []*DatasetSnapshotFile) (bool, error) {
+	ctx := context.Background()
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctx, "UPDATE dataset_snapshot SET status = ?, published = NOW() WHERE id = ? AND status = ?", DATASET_SNAPSHOT_PUBLISHED, id, DATASET_SNAPSHOT_DRAFT)
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return false, err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil || affected != 1 {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return false, err
+	}
+
+	for _, file := range files {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO dataset_snapshot_file (snapshot_id, name, sha256, size, content) VALUES (?, ?, ?, ?, ?)", id, file.Name, file.Sha256, file.Size, file.Content); err != nil {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteDatasetSnapshot removes a draft snapshot, returning false if it has been published
+func (db *Db) DeleteDatasetSnapshot(id int) (bool, error) {
+	ctx := context.Background()
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM dataset_snapshot WHERE id = ? AND status = ?", id, DATASET_SNAPSHOT_DRAFT)
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return false, err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil || affected != 1 {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM dataset_snapshot_entry WHERE snapshot_id = ?", id); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -86,18 +86,21 @@ func (db *Db) CreateEdit(id int, eg *EditGroup, required, classification int) er
 	if err := tx.Commit(); err != nil {
 		return err
 	}
-	return nil
+	if err := db.recordNewEditLabels([]int{id}); err != nil {
+		return err
+	}
+	return db.IncrementDataVersion()
 }
 
 func (db *Db) LookupEditById(id int) (*Edit, error) {
-	results, err := db.db.Query("SELECT id, required, classification "+
+	results, err := db.db.Query("SELECT edit.id, edit.required, edit.classification, "+
 		"COUNT(DISTINCT user_classification_vandalism.id) AS user_classifications_vandalism, "+
 		"COUNT(DISTINCT user_classification_constructive.id) AS user_classifications_constructive, "+
 		"COUNT(DISTINCT user_classification_skipped.id) AS user_classifications_skipped "+
 		"FROM edit "+
-		"LEFT JOIN user_classification AS user_classification_vandalism ON (user_classification_vandalism.edit_id = edit.id AND user_classification_vandalism.classification = 0) "+
-		"LEFT JOIN user_classification AS user_classification_constructive ON (user_classification_constructive.edit_id = edit.id AND user_classification_constructive.classification = 1) "+
-		"LEFT JOIN user_classification AS user_classification_skipped ON (user_classification_skipped.edit_id = edit.id AND user_classification_skipped.classification = 2) "+
+		"LEFT JOIN user_classification AS user_classification_vandalism ON (user_classification_vandalism.edit_id = edit.id AND user_classification_vandalism.classification = 0 AND user_classification_vandalism.quarantined = 0) "+
+		"LEFT JOIN user_classification AS user_classification_constructive ON (user_classification_constructive.edit_id = edit.id AND user_classification_constructive.classification = 1 AND user_classification_constructive.quarantined = 0) "+
+		"LEFT JOIN user_classification AS user_classification_skipped ON (user_classification_skipped.edit_id = edit.id AND user_classification_skipped.classification = 2 AND user_classification_skipped.quarantined = 0) "+
 		"WHERE edit.id = ? GROUP BY edit.id, edit.required, edit.classification", id)
 	if err != nil {
 		return nil, err
@@ -119,6 +122,7 @@ func (db *Db) LookupEditById(id int) (*Edit, error) {
 	return edit, nil
 }
 
+// LookupEditsByGroupId returns the edits in the group, excluding those whose revision is unavailable
 func (db *Db) LookupEditsByGroupId(id int) ([]*Edit, error) {
 	results, err := db.db.Query("SELECT edit.id, edit.required, edit.classification, "+
 		"COUNT(DISTINCT user_classification_vandalism.id) AS user_classifications_vandalism, "+
@@ -127,10 +131,11 @@ func (db *Db) LookupEditsByGroupId(id int) ([]*Edit, error) {
 		"FROM edit "+
 		"INNER JOIN edit_edit_group ON (edit_edit_group.edit_id = edit.id) "+
 		"INNER JOIN edit_group ON (edit_group.id = edit_edit_group.edit_group_id) "+
-		"LEFT JOIN user_classification AS user_classification_vandalism ON (user_classification_vandalism.edit_id = edit.id AND user_classification_vandalism.classification = 0) "+
-		"LEFT JOIN user_classification AS user_classification_constructive ON (user_classification_constructive.edit_id = edit.id AND user_classification_constructive.classification = 1) "+
-		"LEFT JOIN user_classification AS user_classification_skipped ON (user_classification_skipped.edit_id = edit.id AND user_classification_skipped.classification = 2) "+
-		"WHERE edit_group.id = ? "+
+		"LEFT JOIN edit_unavailable ON (edit_unavailable.edit_id = edit.id) "+
+		"LEFT JOIN user_classification AS user_classification_vandalism ON (user_classification_vandalism.edit_id = edit.id AND user_classification_vandalism.classification = 0 AND user_classification_vandalism.quarantined = 0) "+
+		"LEFT JOIN user_classification AS user_classification_constructive ON (user_classification_constructive.edit_id = edit.id AND user_classification_constructive.classification = 1 AND user_classification_constructive.quarantined = 0) "+
+		"LEFT JOIN user_classification AS user_classification_skipped ON (user_classification_skipped.edit_id = edit.id AND user_classification_skipped.classification = 2 AND user_classification_skipped.quarantined = 0) "+
+		"WHERE edit_group.id = ? AND edit_unavailable.edit_id IS NULL "+
 		"GROUP BY edit.id, edit.required, edit.classification", id)
 	if err != nil {
 		return nil, err
@@ -160,9 +165,9 @@ func (db *Db) FetchAllEdits() ([]*Edit, error) {
 		"FROM edit " +
 		"INNER JOIN edit_edit_group ON (edit_edit_group.edit_id = edit.id) " +
 		"INNER JOIN edit_group ON (edit_group.id = edit_edit_group.edit_group_id) " +
-		"LEFT JOIN user_classification AS user_classification_vandalism ON (user_classification_vandalism.edit_id = edit.id AND user_classification_vandalism.classification = 0) " +
-		"LEFT JOIN user_classification AS user_classification_constructive ON (user_classification_constructive.edit_id = edit.id AND user_classification_constructive.classification = 1) " +
-		"LEFT JOIN user_classification AS user_classification_skipped ON (user_classification_skipped.edit_id = edit.id AND user_classification_skipped.classification = 2) " +
+		"LEFT JOIN user_classification AS user_classification_vandalism ON (user_classification_vandalism.edit_id = edit.id AND user_classification_vandalism.classification = 0 AND user_classification_vandalism.quarantined = 0) " +
+		"LEFT JOIN user_classification AS user_classification_constructive ON (user_classification_constructive.edit_id = edit.id AND user_classification_constructive.classification = 1 AND user_classification_constructive.quarantined = 0) " +
+		"LEFT JOIN user_classification AS user_classification_skipped ON (user_classification_skipped.edit_id = edit.id AND user_classification_skipped.classification = 2 AND user_classification_skipped.quarantined = 0) " +
 		"GROUP BY edit.id, edit.required, edit.classification")
 	if err != nil {
 		return nil, err
@@ -0,0 +1,183 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"database/sql"
+)
+
+// EditCursor iterates over edits as they are read from the database, without loading the full set
+type EditCursor struct {
+	results *sql.Rows
+}
+
+func (c *EditCursor) Next() (*Edit, error) {
+	if !c.results.Next() {
+		return nil, c.results.Err()
+	}
+
+	edit := &Edit{}
+	if err := c.results.Scan(&edit.Id, &edit.Required, &edit.Classification, &edit.UserClassificationsVandalism, &edit.UserClassificationsConstructive, &edit.UserClassificationsSkipped); err != nil {
+		return nil, err
+	}
+	return edit, nil
+}
+
+func (c *EditCursor) Close() error {
+	return c.results.Close()
+}
+
+// UserClassificationCursor iterates over user classifications as they are read from the database
+type UserClassificationCursor struct {
+	results *sql.Rows
+}
+
+func (c *UserClassificationCursor) Next() (*UserClassification, error) {
+	if !c.results.Next() {
+		return nil, c.results.Err()
+	}
+
+	uc := &UserClassification{}
+	if err := c.results.Scan(&uc.Id, &uc.UserId, &uc.Comment, &uc.Classification, &uc.EditId, &uc.Created); err != nil {
+		return nil, err
+	}
+	return uc, nil
+}
+
+func (c *UserClassificationCursor) Close() error {
+	return c.results.Close()
+}
+
+func (db *Db) StreamEditsByGroupId(id int) (*EditCursor, error) {
+	results, err := db.db.Query("SELECT edit.id, edit.required, edit.classification, "+
+		"COUNT(DISTINCT user_classification_vandalism.id) AS user_classifications_vandalism, "+
+		"COUNT(DISTINCT user_classification_constructive.id) AS user_classifications_constructive, "+
+		"COUNT(DISTINCT user_classification_skipped.id) AS user_classifications_skipped "+
+		"FROM edit "+
+		"INNER JOIN edit_edit_group ON (edit_edit_group.edit_id = edit.id) "+
+		"LEFT JOIN edit_unavailable ON (edit_unavailable.edit_id = edit.id) "+
+		"LEFT JOIN user_classification AS user_classification_vandalism ON (user_classification_vandalism.edit_id = edit.id AND user_classification_vandalism.classification = 0 AND user_classification_vandalism.quarantined = 0) "+
+		"LEFT JOIN user_classification AS user_classification_constructive ON (user_classification_constructive.edit_id = edit.id AND user_classification_constructive.classification = 1 AND user_classification_constructive.quarantined = 0) "+
+		"LEFT JOIN user_classification AS user_classification_skipped ON (user_classification_skipped.edit_id = edit.id AND user_classification_skipped.classification = 2 AND user_classification_skipped.quarantined = 0) "+
+		"WHERE edit_edit_group.edit_group_id = ? AND edit_unavailable.edit_id IS NULL "+
+		"GROUP BY edit.id, edit.required, edit.classification "+
+		"ORDER BY edit.id", id)
+	if err != nil {
+		return nil, err
+	}
+	return &EditCursor{results: results}, nil
+}
+
+// StreamEdits returns every available edit after the id, ordered by edit id
+func (db *Db) StreamEdits(afterEditId int) (*EditCursor, error) {
+	results, err := db.db.Query("SELECT edit.id, edit.required, edit.classification, "+
+		"COUNT(DISTINCT user_classification_vandalism.id) AS user_classifications_vandalism, "+
+		"COUNT(DISTINCT user_classification_constructive.id) AS user_classifications_constructive, "+
+		"COUNT(DISTINCT user_classification_skipped.id) AS user_classifications_skipped "+
+		"FROM edit "+
+		"LEFT JOIN edit_unavailable ON (edit_unavailable.edit_id = edit.id) "+
+		"LEFT JOIN user_classification AS user_classification_vandalism ON (user_classification_vandalism.edit_id = edit.id AND user_classification_vandalism.classification = 0 AND user_classification_vandalism.quarantined = 0) "+
+		"LEFT JOIN user_classification AS user_classification_constructive ON (user_classification_constructive.edit_id = edit.id AND user_classification_constructive.classification = 1 AND user_classification_constructive.quarantined = 0) "+
+		"LEFT JOIN user_classification AS user_classification_skipped ON (user_classification_skipped.edit_id = edit.id AND user_classification_skipped.classification = 2 AND user_classification_skipped.quarantined = 0) "+
+		"WHERE edit.id > ? AND edit_unavailable.edit_id IS NULL "+
+		"GROUP BY edit.id, edit.required, edit.classification "+
+		"ORDER BY edit.id", afterEditId)
+	if err != nil {
+		return nil, err
+	}
+	return &EditCursor{results: results}, nil
+}
+
+// EditGroupMembership is an edit belonging to an edit group
+type EditGroupMembership struct {
+	EditId      int
+	EditGroupId int
+}
+
+// EditGroupMembershipCursor iterates over the groups of each edit as they are read from the database
+type EditGroupMembershipCursor struct {
+	results *sql.Rows
+}
+
+func (c *EditGroupMembershipCursor) Next() (*EditGroupMembership, error) {
+	if !c.results.Next() {
+		return nil, c.results.Err()
+	}
+
+	membership := &EditGroupMembership{}
+	if err := c.results.Scan(&membership.EditId, &membership.EditGroupId); err != nil {
+		return nil, err
+	}
+	return membership, nil
+}
+
+func (c *EditGroupMembershipCursor) Close() error {
+	return c.results.Close()
+}
+
+// StreamEditGroupMemberships returns the groups of every edit after the id, in the same order as StreamEdits
+func (db *Db) StreamEditGroupMemberships(afterEditId int) (*EditGroupMembershipCursor, error) {
+	results, err := db.db.Query("SELECT edit_id, edit_group_id FROM edit_edit_group WHERE edit_id > ? ORDER BY edit_id, edit_group_id", afterEditId)
+	if err != nil {
+		return nil, err
+	}
+	return &EditGroupMembershipCursor{results: results}, nil
+}
+
+// StreamUserClassificationsByGroupId returns the classifications for a group in the same order as StreamEditsByGroupId
+func (db *Db) StreamUserClassificationsByGroupId(id int) (*UserClassificationCursor, error) {
+	results, err := db.db.Query("SELECT user_classification.id, user_classification.user_id, user_classification.comment, "+
+		"user_classification.classification, user_classification.edit_id, user_classification.created "+
+		"FROM user_classification "+
+		"INNER JOIN edit_edit_group ON (edit_edit_group.edit_id = user_classification.edit_id) "+
+		"WHERE edit_edit_group.edit_group_id = ? AND user_classification.quarantined = 0 "+
+		"ORDER BY user_classification.edit_id, user_classification.id", id)
+	if err != nil {
+		return nil, err
+	}
+	return &UserClassificationCursor{results: results}, nil
+}
+
+func (db *Db) CalculateAllUserClassificationTotals() (map[int]int, error) {
+	results, err := db.db.Query("SELECT users.id, users.legacy_count + COUNT(user_classification.id) FROM users " +
+		"LEFT JOIN user_classification ON (user_classification.user_id = users.id AND user_classification.quarantined = 0) " +
+		"GROUP BY users.id, users.legacy_count")
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[int]int{}
+	for results.Next() {
+		var userId, total int
+		if err := results.Scan(&userId, &total); err != nil {
+			return nil, err
+		}
+		totals[userId] = total
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
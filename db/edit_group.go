@@ -30,10 +30,11 @@ type EditGroup struct {
 	Id     int
 	Name   string
 	Weight int
+	Wiki   string
 }
 
 func (db *Db) LookupEditGroupById(id int) (*EditGroup, error) {
-	results, err := db.db.Query("SELECT id, name, weight FROM edit_group WHERE id = ?", id)
+	results, err := db.db.Query("SELECT id, name, weight, wiki FROM edit_group WHERE id = ?", id)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +44,7 @@ func (db *Db) LookupEditGroupById(id int) (*EditGroup, error) {
 	}
 
 	group := &EditGroup{}
-	if err := results.Scan(&group.Id, &group.Name, &group.Weight); err != nil {
+	if err := results.Scan(&group.Id, &group.Name, &group.Weight, &group.Wiki); err != nil {
 		return nil, err
 	}
 
@@ -55,7 +56,7 @@ func (db *Db) LookupEditGroupById(id int) (*EditGroup, error) {
 }
 
 func (db *Db) LookupEditGroupByName(name string) (*EditGroup, error) {
-	results, err := db.db.Query("SELECT id, name, weight FROM edit_group WHERE name = ?", name)
+	results, err := db.db.Query("SELECT id, name, weight, wiki FROM edit_group WHERE name = ?", name)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +66,7 @@ func (db *Db) LookupEditGroupByName(name string) (*EditGroup, error) {
 	}
 
 	group := &EditGroup{}
-	if err := results.Scan(&group.Id, &group.Name, &group.Weight); err != nil {
+	if err := results.Scan(&group.Id, &group.Name, &group.Weight, &group.Wiki); err != nil {
 		return nil, err
 	}
 
@@ -77,7 +78,7 @@ func (db *Db) LookupEditGroupByName(name string) (*EditGroup, error) {
 }
 
 func (db *Db) FetchAllEditGroups() ([]*EditGroup, error) {
-	results, err := db.db.Query("SELECT id, name, weight FROM edit_group")
+	results, err := db.db.Query("SELECT id, name, weight, wiki FROM edit_group")
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +86,7 @@ func (db *Db) FetchAllEditGroups() ([]*EditGroup, error) {
 	editGroups := []*EditGroup{}
 	for results.Next() {
 		editGroup := &EditGroup{}
-		if err := results.Scan(&editGroup.Id, &editGroup.Name, &editGroup.Weight); err != nil {
+		if err := results.Scan(&editGroup.Id, &editGroup.Name, &editGroup.Weight, &editGroup.Wiki); err != nil {
 			return nil, err
 		}
 		editGroups = append(editGroups, editGroup)
@@ -98,8 +99,30 @@ func (db *Db) FetchAllEditGroups() ([]*EditGroup, error) {
 	return editGroups, nil
 }
 
-func (db *Db) CalculateRandomPendingEditForUser(user *User) (*Edit, error) {
-	allGroups, err := db.FetchAllEditGroups()
+func (db *Db) FetchEditGroupsByWiki(wiki string) ([]*EditGroup, error) {
+	results, err := db.db.Query("SELECT id, name, weight, wiki FROM edit_group WHERE wiki = ?", wiki)
+	if err != nil {
+		return nil, err
+	}
+
+	editGroups := []*EditGroup{}
+	for results.Next() {
+		editGroup := &EditGroup{}
+		if err := results.Scan(&editGroup.Id, &editGroup.Name, &editGroup.Weight, &editGroup.Wiki); err != nil {
+			return nil, err
+		}
+		editGroups = append(editGroups, editGroup)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return editGroups, nil
+}
+
+func (db *Db) CalculateRandomPendingEditForUser(user *User, wiki string) (*Edit, error) {
+	allGroups, err := db.FetchEditGroupsByWiki(wiki)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +142,12 @@ func (db *Db) CalculateRandomPendingEditForUser(user *User) (*Edit, error) {
 		knownUserEdits[userClassification.EditId] = true
 	}
 
+	// Deleted revisions can't be shown to reviewers
+	unavailableEdits, err := db.LookupUnavailableEditIds()
+	if err != nil {
+		return nil, err
+	}
+
 	for _, group := range allGroups {
 		// Calculate if we have some edits in this selected group
 		groupEdits, err := db.LookupEditsByGroupId(group.Id)
@@ -130,6 +159,9 @@ func (db *Db) CalculateRandomPendingEditForUser(user *User) (*Edit, error) {
 			if _, ok := knownUserEdits[edit.Id]; ok {
 				continue
 			}
+			if _, ok := unavailableEdits[edit.Id]; ok {
+				continue
+			}
 
 			// Return the edit if it still needs classifying
 			if edit.ReviewedClassification() == EDIT_CLASSIFICATION_UNKNOWN {
@@ -139,3 +171,50 @@ func (db *Db) CalculateRandomPendingEditForUser(user *User) (*Edit, error) {
 	}
 	return nil, nil
 }
+
+func (db *Db) LookupEditGroupIdsByEditId(editId int) ([]int, error) {
+	results, err := db.db.Query("SELECT edit_group_id FROM edit_edit_group WHERE edit_id = ?", editId)
+	if err != nil {
+		return nil, err
+	}
+
+	editGroupIds := []int{}
+	for results.Next() {
+		var editGroupId int
+		if err := results.Scan(&editGroupId); err != nil {
+			return nil, err
+		}
+		editGroupIds = append(editGroupIds, editGroupId)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return editGroupIds, nil
+}
+
+// LookupEditWiki returns the wiki of the edit's (first) group, or an empty string when it isn't in a group
+func (db *Db) LookupEditWiki(editId int) (string, error) {
+	results, err := db.db.Query("SELECT edit_group.wiki FROM edit_edit_group "+
+		"INNER JOIN edit_group ON (edit_group.id = edit_edit_group.edit_group_id) "+
+		"WHERE edit_edit_group.edit_id = ? ORDER BY edit_group.id LIMIT 1", editId)
+	if err != nil {
+		return "", err
+	}
+
+	if !results.Next() {
+		return "", nil
+	}
+
+	var wiki string
+	if err := results.Scan(&wiki); err != nil {
+		return "", err
+	}
+
+	if err := results.Close(); err != nil {
+		return "", err
+	}
+
+	return wiki, nil
+}
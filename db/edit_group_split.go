@@ -0,0 +1,139 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+)
+
+// EditGroupSplit records the split definition which created an edit group, only those groups are replaced by a split
+type EditGroupSplit struct {
+	EditGroupId int
+	Name        string
+	Split       string
+}
+
+// EditGroupSplitMembers is the content of one split, materialised into the named group
+type EditGroupSplitMembers struct {
+	Split         string
+	EditGroupName string
+	EditIds       []int
+}
+
+func (db *Db) LookupEditGroupSplit(editGroupId int) (*EditGroupSplit, error) {
+	results, err := db.db.Query("SELECT edit_group_id, name, split FROM edit_group_split WHERE edit_group_id = ?", editGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !results.Next() {
+		return nil, nil
+	}
+
+	s := &EditGroupSplit{}
+	if err := results.Scan(&s.EditGroupId, &s.Name, &s.Split); err != nil {
+		return nil, err
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// materialiseEditGroupSplit returns the group owned by the split, creating it when no group has the name
+func materialiseEditGroupSplit(ctx context.Context, tx *sql.Tx, name, wiki string, weight int, members *EditGroupSplitMembers) (int, error) {
+	var editGroupId int
+	var ownerName, ownerSplit sql.NullString
+	err := tx.QueryRowContext(ctx, "SELECT edit_group.id, edit_group_split.name, edit_group_split.split FROM edit_group "+
+		"LEFT JOIN edit_group_split ON (edit_group_split.edit_group_id = edit_group.id) "+
+		"WHERE edit_group.name = ? LIMIT 1 FOR UPDATE", members.EditGroupName).Scan(&editGroupId, &ownerName, &ownerSplit)
+	if err == sql.ErrNoRows {
+		result, err := tx.ExecContext(ctx, "INSERT INTO edit_group (name, weight, wiki) VALUES (?, ?, ?)", members.EditGroupName, weight, wiki)
+		if err != nil {
+			return 0, err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO edit_group_split (edit_group_id, name, split) VALUES (?, ?, ?)", id, name, members.Split); err != nil {
+			return 0, err
+		}
+		return int(id), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if !ownerName.Valid || ownerName.String != name || ownerSplit.String != members.Split {
+		return 0, fmt.Errorf("edit group %s was not created by split %s", members.EditGroupName, name)
+	}
+	return editGroupId, nil
+}
+
+// MaterialiseEditGroupSplit replaces the members of every split group in one transaction, returning the group id of each split
+func (db *Db) MaterialiseEditGroupSplit(name, wiki string, weight int, splits []*EditGroupSplitMembers) (map[string]int, error) {
+	ctx := context.Background()
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	editGroupIds := map[string]int{}
+	for _, members := range splits {
+		editGroupId, err := materialiseEditGroupSplit(ctx, tx, name, wiki, weight, members)
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return nil, err
+		}
+		editGroupIds[members.Split] = editGroupId
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM edit_edit_group WHERE edit_group_id = ?", editGroupId); err != nil {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return nil, err
+		}
+
+		for _, editId := range members.EditIds {
+			if _, err := tx.ExecContext(ctx, "INSERT INTO edit_edit_group (edit_id, edit_group_id) VALUES (?, ?)", editId, editGroupId); err != nil {
+				if err := tx.Rollback(); err != nil {
+					log.Fatal(err)
+				}
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return editGroupIds, db.IncrementDataVersion()
+}
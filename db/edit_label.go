@@ -0,0 +1,224 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"strings"
+	"time"
+)
+
+type EditLabelChange struct {
+	Id                int       `json:"id"`
+	EditId            int       `json:"edit_id"`
+	OldClassification int       `json:"old_classification"`
+	NewClassification int       `json:"new_classification"`
+	Created           time.Time `json:"created"`
+}
+
+func (db *Db) lookupLatestEditLabel(editId int) (int, error) {
+	results, err := db.db.Query("SELECT new_classification FROM edit_label_change WHERE edit_id = ? ORDER BY id DESC LIMIT 1", editId)
+	if err != nil {
+		return -1, err
+	}
+
+	// Edits without a recorded change have not reached a consensus through review
+	label := EDIT_CLASSIFICATION_UNKNOWN
+	if results.Next() {
+		if err := results.Scan(&label); err != nil {
+			return -1, err
+		}
+	}
+
+	if err := results.Close(); err != nil {
+		return -1, err
+	}
+
+	return label, nil
+}
+
+// RecordEditLabel logs the current consensus of the edit after its reviews change, returning the change when it
+// differs from the last recorded label. Entries with an unchanged label mark a change to the votes, for the report sync.
+func (db *Db) RecordEditLabel(editId int) (*EditLabelChange, error) {
+	edit, err := db.LookupEditById(editId)
+	if err != nil {
+		return nil, err
+	}
+	if edit == nil {
+		return nil, nil
+	}
+
+	previousLabel, err := db.lookupLatestEditLabel(editId)
+	if err != nil {
+		return nil, err
+	}
+
+	currentLabel := edit.ReviewedClassification()
+	result, err := db.db.Exec("INSERT INTO edit_label_change (edit_id, old_classification, new_classification) VALUES (?, ?, ?)", editId, previousLabel, currentLabel)
+	if err != nil {
+		return nil, err
+	}
+	changeId, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	if currentLabel == previousLabel {
+		return nil, nil
+	}
+	return &EditLabelChange{
+		Id:                int(changeId),
+		EditId:            editId,
+		OldClassification: previousLabel,
+		NewClassification: currentLabel,
+		Created:           time.Now(),
+	}, nil
+}
+
+// recordNewEditLabels logs newly created edits as unlabelled, called once they are committed so the ids follow commit order
+func (db *Db) recordNewEditLabels(editIds []int) error {
+	if len(editIds) == 0 {
+		return nil
+	}
+
+	placeholders, args := []string{}, []interface{}{}
+	for _, editId := range editIds {
+		placeholders = append(placeholders, "(?, ?, ?)")
+		args = append(args, editId, EDIT_CLASSIFICATION_UNKNOWN, EDIT_CLASSIFICATION_UNKNOWN)
+	}
+	if _, err := db.db.Exec("INSERT INTO edit_label_change (edit_id, old_classification, new_classification) VALUES "+strings.Join(placeholders, ", "), args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RecordEditLabelsForUser records the labels of every edit the user reviewed, returning those which changed
+func (db *Db) RecordEditLabelsForUser(userId int) ([]*EditLabelChange, error) {
+	results, err := db.db.Query("SELECT edit_id FROM user_classification WHERE user_id = ?", userId)
+	if err != nil {
+		return nil, err
+	}
+
+	editIds := []int{}
+	for results.Next() {
+		var editId int
+		if err := results.Scan(&editId); err != nil {
+			return nil, err
+		}
+		editIds = append(editIds, editId)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	changes := []*EditLabelChange{}
+	for _, editId := range editIds {
+		change, err := db.RecordEditLabel(editId)
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+func (db *Db) CalculateEditLabelCursor() (int, error) {
+	results, err := db.db.Query("SELECT COALESCE(MAX(id), 0) FROM edit_label_change")
+	if err != nil {
+		return -1, err
+	}
+
+	if !results.Next() {
+		return 0, nil
+	}
+
+	var cursor int
+	if err := results.Scan(&cursor); err != nil {
+		return -1, err
+	}
+
+	if err := results.Close(); err != nil {
+		return -1, err
+	}
+
+	return cursor, nil
+}
+
+func (db *Db) lookupEditIds(query string, args ...interface{}) (map[int]bool, error) {
+	results, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	editIds := map[int]bool{}
+	for results.Next() {
+		var editId int
+		if err := results.Scan(&editId); err != nil {
+			return nil, err
+		}
+		editIds[editId] = true
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return editIds, nil
+}
+
+func (db *Db) LookupEditIdsLabelChangedSince(cursor int) (map[int]bool, error) {
+	return db.lookupEditIds("SELECT DISTINCT edit_id FROM edit_label_change WHERE id > ? AND old_classification != new_classification", cursor)
+}
+
+func (db *Db) LookupEditIdsClassifiedBetween(from, to time.Time) (map[int]bool, error) {
+	return db.lookupEditIds("SELECT DISTINCT edit_id FROM user_classification WHERE quarantined = 0 AND created >= ? AND created < ?", from, to)
+}
+
+// LookupEditChangesSince returns up to limit entries after the cursor for edits in the group, including unchanged labels, ordered by id
+func (db *Db) LookupEditChangesSince(editGroupId, cursor, limit int) ([]*EditLabelChange, error) {
+	results, err := db.db.Query("SELECT edit_label_change.id, edit_label_change.edit_id, edit_label_change.old_classification, "+
+		"edit_label_change.new_classification, edit_label_change.created FROM edit_label_change "+
+		"INNER JOIN edit_edit_group ON (edit_edit_group.edit_id = edit_label_change.edit_id) "+
+		"WHERE edit_label_change.id > ? AND edit_edit_group.edit_group_id = ? "+
+		"ORDER BY edit_label_change.id LIMIT ?", cursor, editGroupId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []*EditLabelChange{}
+	for results.Next() {
+		change := &EditLabelChange{}
+		if err := results.Scan(&change.Id, &change.EditId, &change.OldClassification, &change.NewClassification, &change.Created); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
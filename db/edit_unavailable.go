@@ -0,0 +1,101 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"time"
+)
+
+// EditUnavailable is an edit whose revision has been deleted or suppressed on the wiki
+type EditUnavailable struct {
+	EditId   int       `json:"edit_id"`
+	Reason   string    `json:"reason"`
+	Detected time.Time `json:"detected"`
+	Checked  time.Time `json:"checked"`
+}
+
+func (db *Db) FetchAllUnavailableEdits() ([]*EditUnavailable, error) {
+	results, err := db.db.Query("SELECT edit_id, reason, detected, checked FROM edit_unavailable ORDER BY edit_id")
+	if err != nil {
+		return nil, err
+	}
+
+	unavailableEdits := []*EditUnavailable{}
+	for results.Next() {
+		unavailable := &EditUnavailable{}
+		if err := results.Scan(&unavailable.EditId, &unavailable.Reason, &unavailable.Detected, &unavailable.Checked); err != nil {
+			return nil, err
+		}
+		unavailableEdits = append(unavailableEdits, unavailable)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return unavailableEdits, nil
+}
+
+func (db *Db) LookupUnavailableEditIds() (map[int]bool, error) {
+	results, err := db.db.Query("SELECT edit_id FROM edit_unavailable")
+	if err != nil {
+		return nil, err
+	}
+
+	editIds := map[int]bool{}
+	for results.Next() {
+		var editId int
+		if err := results.Scan(&editId); err != nil {
+			return nil, err
+		}
+		editIds[editId] = true
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return editIds, nil
+}
+
+// MarkEditUnavailable records the edit as unavailable, keeping when it was first detected
+func (db *Db) MarkEditUnavailable(editId int, reason string) error {
+	if _, err := db.db.Exec("INSERT INTO edit_unavailable (edit_id, reason, detected, checked) VALUES (?, ?, NOW(), NOW()) "+
+		"ON DUPLICATE KEY UPDATE reason = VALUES(reason), checked = NOW()", editId, reason); err != nil {
+		return err
+	}
+	return db.IncrementDataVersion()
+}
+
+// MarkEditAvailable returns a previously unavailable edit to the queue and exports, false if it was not unavailable
+func (db *Db) MarkEditAvailable(editId int) (bool, error) {
+	result, err := db.db.Exec("DELETE FROM edit_unavailable WHERE edit_id = ?", editId)
+	if err != nil {
+		return false, err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil || affected != 1 {
+		return false, err
+	}
+	return true, db.IncrementDataVersion()
+}
@@ -0,0 +1,126 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type JobRun struct {
+	Id         int        `json:"id"`
+	Name       string     `json:"name"`
+	Trigger    string     `json:"trigger"`
+	Status     int        `json:"status"`
+	Error      string     `json:"error"`
+	Started    time.Time  `json:"started"`
+	Finished   *time.Time `json:"finished"`
+	DurationMs int64      `json:"duration_ms"`
+}
+
+// JobLock is a named MySQL lock, held by the connection it was taken on
+type JobLock struct {
+	conn *sql.Conn
+	name string
+}
+
+func (db *Db) AcquireJobLock(name string) (*JobLock, error) {
+	ctx := context.Background()
+	conn, err := db.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lockName := "reviewng-job-" + name
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", lockName).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if !acquired.Valid || acquired.Int64 != 1 {
+		conn.Close()
+		return nil, nil
+	}
+	return &JobLock{conn: conn, name: lockName}, nil
+}
+
+func (l *JobLock) Release() error {
+	defer l.conn.Close()
+	if _, err := l.conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", l.name); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (db *Db) CreateJobRun(name, trigger string) (int, error) {
+	result, err := db.db.Exec("INSERT INTO jobs (name, `trigger`, status, error) VALUES (?, ?, ?, ?)", name, trigger, JOB_RUNNING, "")
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
+
+func (db *Db) FinishJobRun(id int, duration time.Duration, runErr error) error {
+	status, message := JOB_SUCCEEDED, ""
+	if runErr != nil {
+		status, message = JOB_FAILED, runErr.Error()
+	}
+
+	if _, err := db.db.Exec("UPDATE jobs SET status = ?, error = ?, finished = NOW(), duration_ms = ? WHERE id = ?", status, message, duration.Milliseconds(), id); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (db *Db) LookupRecentJobRuns(limit int) ([]*JobRun, error) {
+	results, err := db.db.Query("SELECT id, name, `trigger`, status, error, started, finished, duration_ms FROM jobs ORDER BY id DESC LIMIT ?", limit)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := []*JobRun{}
+	for results.Next() {
+		run := &JobRun{}
+		var finished sql.NullTime
+		if err := results.Scan(&run.Id, &run.Name, &run.Trigger, &run.Status, &run.Error, &run.Started, &finished, &run.DurationMs); err != nil {
+			return nil, err
+		}
+		if finished.Valid {
+			run.Finished = &finished.Time
+		}
+		runs = append(runs, run)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,83 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"time"
+)
+
+type LeaderboardEntry struct {
+	UserId     int    `json:"user_id"`
+	Username   string `json:"username"`
+	Last7Days  int    `json:"last_7_days"`
+	Last30Days int    `json:"last_30_days"`
+	AllTime    int    `json:"all_time"`
+}
+
+// CalculateLeaderboard returns the classification counts for every user over each window,
+// restricted to a single edit group when editGroupId is non-zero.
+func (db *Db) CalculateLeaderboard(editGroupId int) ([]*LeaderboardEntry, error) {
+	now := time.Now().UTC()
+	joinCondition := "user_classification.user_id = users.id AND user_classification.quarantined = 0"
+	args := []interface{}{now.AddDate(0, 0, -7), now.AddDate(0, 0, -30)}
+	if editGroupId != 0 {
+		joinCondition += " AND user_classification.edit_id IN (SELECT edit_id FROM edit_edit_group WHERE edit_group_id = ?)"
+		args = append(args, editGroupId)
+	}
+
+	query := "SELECT users.id, users.username, users.legacy_count, " +
+		"COALESCE(SUM(user_classification.created >= ?), 0), " +
+		"COALESCE(SUM(user_classification.created >= ?), 0), " +
+		"COUNT(user_classification.id) " +
+		"FROM users " +
+		"LEFT JOIN user_classification ON (" + joinCondition + ") " +
+		"GROUP BY users.id, users.username, users.legacy_count"
+
+	results, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*LeaderboardEntry{}
+	for results.Next() {
+		var legacyCount int
+		entry := &LeaderboardEntry{}
+		if err := results.Scan(&entry.UserId, &entry.Username, &legacyCount, &entry.Last7Days, &entry.Last30Days, &entry.AllTime); err != nil {
+			return nil, err
+		}
+
+		// Legacy counts pre-date edit groups, so only apply to the global board
+		if editGroupId == 0 {
+			entry.AllTime += legacyCount
+		}
+		if entry.AllTime > 0 {
+			entries = append(entries, entry)
+		}
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
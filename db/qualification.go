@@ -0,0 +1,262 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+)
+
+type QualificationClassification struct {
+	Id             int
+	UserId         int
+	EditId         int
+	Classification int
+}
+
+type UserQualification struct {
+	UserId    int       `json:"user_id"`
+	Total     int       `json:"total"`
+	Correct   int       `json:"correct"`
+	Score     float32   `json:"score"`
+	Outcome   int       `json:"outcome"`
+	Completed time.Time `json:"completed"`
+}
+
+// QualificationEdit is an edit in a user's practice set, with the label their vote is scored against
+type QualificationEdit struct {
+	EditId         int
+	Classification int
+}
+
+// selectQualificationEdits picks the practice set, the first edits by id in the group with a decided label
+func (db *Db) selectQualificationEdits(editGroupId, limit int) ([]*QualificationEdit, error) {
+	groupEdits, err := db.LookupEditsByGroupId(editGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(groupEdits, func(i, j int) bool {
+		return groupEdits[i].Id < groupEdits[j].Id
+	})
+
+	edits := []*QualificationEdit{}
+	for _, edit := range groupEdits {
+		if limit > 0 && len(edits) >= limit {
+			break
+		}
+		if edit.ReviewedClassification() == EDIT_CLASSIFICATION_VANDALISM || edit.ReviewedClassification() == EDIT_CLASSIFICATION_CONSTRUCTIVE {
+			edits = append(edits, &QualificationEdit{EditId: edit.Id, Classification: edit.ReviewedClassification()})
+		}
+	}
+	return edits, nil
+}
+
+// LookupQualificationEdits returns the user's practice set in order.
+// The set and its labels are stored when the user starts, so later votes on the group don't change it.
+func (db *Db) LookupQualificationEdits(userId, editGroupId, limit int) ([]*QualificationEdit, error) {
+	ctx := context.Background()
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Serialise starting the qualification for the user
+	if _, err := tx.ExecContext(ctx, "SELECT id FROM users WHERE id = ? FOR UPDATE", userId); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return nil, err
+	}
+
+	results, err := tx.QueryContext(ctx, "SELECT edit_id, classification FROM qualification_edit WHERE user_id = ? ORDER BY position", userId)
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return nil, err
+	}
+
+	edits := []*QualificationEdit{}
+	for results.Next() {
+		edit := &QualificationEdit{}
+		if err := results.Scan(&edit.EditId, &edit.Classification); err != nil {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return nil, err
+		}
+		edits = append(edits, edit)
+	}
+
+	if err := results.Close(); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return nil, err
+	}
+
+	if len(edits) == 0 {
+		edits, err = db.selectQualificationEdits(editGroupId, limit)
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return nil, err
+		}
+
+		for position, edit := range edits {
+			if _, err := tx.ExecContext(ctx, "INSERT INTO qualification_edit (user_id, edit_id, position, classification) VALUES (?, ?, ?, ?)", userId, edit.EditId, position, edit.Classification); err != nil {
+				if err := tx.Rollback(); err != nil {
+					log.Fatal(err)
+				}
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return edits, nil
+}
+
+func (db *Db) CreateQualificationClassification(newClassification QualificationClassification) error {
+	insert, err := db.db.Query("INSERT INTO qualification_classification (user_id, edit_id, classification) VALUES (?, ?, ?)", newClassification.UserId, newClassification.EditId, newClassification.Classification)
+	if err != nil {
+		return err
+	}
+
+	if err := insert.Close(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (db *Db) LookupQualificationClassificationsByUserId(id int) ([]*QualificationClassification, error) {
+	results, err := db.db.Query("SELECT id, user_id, edit_id, classification FROM qualification_classification WHERE user_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	classifications := []*QualificationClassification{}
+	for results.Next() {
+		c := &QualificationClassification{}
+		if err := results.Scan(&c.Id, &c.UserId, &c.EditId, &c.Classification); err != nil {
+			return nil, err
+		}
+		classifications = append(classifications, c)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return classifications, nil
+}
+
+func (db *Db) LookupUserQualification(userId int) (*UserQualification, error) {
+	results, err := db.db.Query("SELECT user_id, total, correct, score, outcome, completed FROM user_qualification WHERE user_id = ?", userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !results.Next() {
+		return nil, nil
+	}
+
+	q := &UserQualification{}
+	if err := results.Scan(&q.UserId, &q.Total, &q.Correct, &q.Score, &q.Outcome, &q.Completed); err != nil {
+		return nil, err
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (db *Db) FetchAllUserQualifications() (map[int]*UserQualification, error) {
+	results, err := db.db.Query("SELECT user_id, total, correct, score, outcome, completed FROM user_qualification")
+	if err != nil {
+		return nil, err
+	}
+
+	qualifications := map[int]*UserQualification{}
+	for results.Next() {
+		q := &UserQualification{}
+		if err := results.Scan(&q.UserId, &q.Total, &q.Correct, &q.Score, &q.Outcome, &q.Completed); err != nil {
+			return nil, err
+		}
+		qualifications[q.UserId] = q
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return qualifications, nil
+}
+
+// ScoreUserQualification compares the practice votes against the labels stored with the practice set,
+// approving the user when the score meets the threshold and flagging them for an admin otherwise.
+func (db *Db) ScoreUserQualification(user *User, edits []*QualificationEdit, threshold float32) (*UserQualification, error) {
+	practiceClassifications, err := db.LookupQualificationClassificationsByUserId(user.Id)
+	if err != nil {
+		return nil, err
+	}
+	practiceByEditId := map[int]int{}
+	for _, c := range practiceClassifications {
+		practiceByEditId[c.EditId] = c.Classification
+	}
+
+	qualification := &UserQualification{UserId: user.Id, Outcome: QUALIFICATION_FLAGGED}
+	for _, edit := range edits {
+		qualification.Total += 1
+		if classification, ok := practiceByEditId[edit.EditId]; ok && classification == edit.Classification {
+			qualification.Correct += 1
+		}
+	}
+	if qualification.Total > 0 {
+		qualification.Score = (float32(qualification.Correct) / float32(qualification.Total)) * 100.00
+	}
+	if qualification.Total > 0 && qualification.Score >= threshold {
+		qualification.Outcome = QUALIFICATION_PASSED
+	}
+
+	if _, err := db.db.Exec("REPLACE INTO user_qualification (user_id, total, correct, score, outcome) VALUES (?, ?, ?, ?, ?)", qualification.UserId, qualification.Total, qualification.Correct, qualification.Score, qualification.Outcome); err != nil {
+		return nil, err
+	}
+
+	if qualification.Outcome == QUALIFICATION_PASSED {
+		if _, err := db.db.Exec("UPDATE users SET approved = 1 WHERE id = ? AND suspended = 0", user.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	qualification.Completed = time.Now()
+	return qualification, nil
+}
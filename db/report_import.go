@@ -0,0 +1,143 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// ReportImport is the outcome of importing the edits marked for review in the report interface
+type ReportImport struct {
+	DryRun   bool     `json:"dry_run"`
+	New      []int    `json:"new"`
+	Existing []int    `json:"existing"`
+	Invalid  []string `json:"invalid"`
+}
+
+// ReportImportRun is a recorded import, with the number of edits in each outcome
+type ReportImportRun struct {
+	Id        int       `json:"id"`
+	Started   time.Time `json:"started"`
+	Finished  time.Time `json:"finished"`
+	DryRun    bool      `json:"dry_run"`
+	Source    string    `json:"source"`
+	EditGroup string    `json:"edit_group"`
+	New       int       `json:"new"`
+	Existing  int       `json:"existing"`
+	Invalid   int       `json:"invalid"`
+	Error     string    `json:"error"`
+}
+
+// ImportReportEdits creates the edits in one transaction, which is rolled back for a dry run
+func (db *Db) ImportReportEdits(editIds []int, editGroupId, required, classification int, dryRun bool) (*ReportImport, error) {
+	reportImport := &ReportImport{DryRun: dryRun, New: []int{}, Existing: []int{}, Invalid: []string{}}
+
+	ctx := context.Background()
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, editId := range editIds {
+		// Existing edits are left untouched, so no rows are affected
+		result, err := tx.ExecContext(ctx, "INSERT INTO edit (id, required, classification) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE id = id", editId, required, classification)
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return nil, err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return nil, err
+		}
+		if affected == 0 {
+			reportImport.Existing = append(reportImport.Existing, editId)
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, "INSERT INTO edit_edit_group (edit_id, edit_group_id) VALUES (?, ?)", editId, editGroupId); err != nil {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return nil, err
+		}
+		reportImport.New = append(reportImport.New, editId)
+	}
+
+	if dryRun {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return reportImport, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	if len(reportImport.New) > 0 {
+		if err := db.recordNewEditLabels(reportImport.New); err != nil {
+			return nil, err
+		}
+		if err := db.IncrementDataVersion(); err != nil {
+			return nil, err
+		}
+	}
+	return reportImport, nil
+}
+
+func (db *Db) CreateReportImportRun(run *ReportImportRun) error {
+	if _, err := db.db.Exec("INSERT INTO report_import_run (started, finished, dry_run, source, edit_group, new, existing, invalid, error) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		run.Started, run.Finished, run.DryRun, run.Source, run.EditGroup, run.New, run.Existing, run.Invalid, run.Error); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (db *Db) LookupRecentReportImportRuns(limit int) ([]*ReportImportRun, error) {
+	results, err := db.db.Query("SELECT id, started, finished, dry_run, source, edit_group, new, existing, invalid, error FROM report_import_run ORDER BY id DESC LIMIT ?", limit)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := []*ReportImportRun{}
+	for results.Next() {
+		run := &ReportImportRun{}
+		if err := results.Scan(&run.Id, &run.Started, &run.Finished, &run.DryRun, &run.Source, &run.EditGroup, &run.New, &run.Existing, &run.Invalid, &run.Error); err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,76 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"strings"
+	"time"
+)
+
+// ReportReview is a reviewer's classification of an edit, as sent to the report interface
+type ReportReview struct {
+	EditId         int
+	Username       string
+	Classification int
+	Comment        string
+	Created        time.Time
+}
+
+// LookupReportReviews returns the reviews for edits in the group keyed by edit id, limited to the edits when any are given
+func (db *Db) LookupReportReviews(editGroupId int, editIds []int) (map[int][]*ReportReview, error) {
+	query := "SELECT user_classification.edit_id, users.username, user_classification.classification, " +
+		"COALESCE(user_classification.comment, ''), user_classification.created " +
+		"FROM user_classification " +
+		"INNER JOIN users ON (users.id = user_classification.user_id) " +
+		"INNER JOIN edit_edit_group ON (edit_edit_group.edit_id = user_classification.edit_id) " +
+		"WHERE edit_edit_group.edit_group_id = ? AND user_classification.quarantined = 0"
+	args := []interface{}{editGroupId}
+	if len(editIds) > 0 {
+		placeholders := []string{}
+		for _, editId := range editIds {
+			placeholders = append(placeholders, "?")
+			args = append(args, editId)
+		}
+		query += " AND user_classification.edit_id IN (" + strings.Join(placeholders, ", ") + ")"
+	}
+
+	results, err := db.db.Query(query+" ORDER BY user_classification.id", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := map[int][]*ReportReview{}
+	for results.Next() {
+		review := &ReportReview{}
+		if err := results.Scan(&review.EditId, &review.Username, &review.Classification, &review.Comment, &review.Created); err != nil {
+			return nil, err
+		}
+		reviews[review.EditId] = append(reviews[review.EditId], review)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
@@ -30,3 +30,24 @@ const EDIT_CLASSIFICATION_VANDALISM = 0
 const EDIT_CLASSIFICATION_CONSTRUCTIVE = 1
 const EDIT_CLASSIFICATION_SKIPPED = 2
 const EDIT_CLASSIFICATION_UNKNOWN = 3
+
+const QUALIFICATION_PASSED = 1
+const QUALIFICATION_FLAGGED = 2
+
+const DATASET_SNAPSHOT_DRAFT = 0
+const DATASET_SNAPSHOT_PUBLISHED = 1
+
+const JOB_RUNNING = 0
+const JOB_SUCCEEDED = 1
+const JOB_FAILED = 2
+
+const TRAINING_DATA_VALID = 0
+const TRAINING_DATA_PARTIAL = 1
+const TRAINING_DATA_INVALID = 2
+
+const TRAINING_DATA_ENCODING_JSON = 0
+const TRAINING_DATA_ENCODING_GZIP = 1
+
+const WEBHOOK_DELIVERY_PENDING = 0
+const WEBHOOK_DELIVERY_DELIVERED = 1
+const WEBHOOK_DELIVERY_FAILED = 2
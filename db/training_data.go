@@ -1,7 +1,13 @@
 package db
 
 import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
 	"encoding/json"
+	"log"
+	"strings"
 )
 
 // MIT License
@@ -62,51 +68,114 @@ type TrainingData struct {
 	IsVandalism bool `json:"is_vandalism"`
 }
 
-func (db *Db) StoreTrainingDataForEdit(id int, td *TrainingData) error {
+// StoreTrainingDataForEdit replaces the training data for the edit, keeping the previous version in the history.
+// Invalid data never replaces valid or partial data, false is returned when it was not stored.
+func (db *Db) StoreTrainingDataForEdit(id int, td *TrainingData, provenance *TrainingDataProvenance) (bool, error) {
 	jsonTrainingData, err := json.Marshal(td)
 	if err != nil {
-		panic(err)
+		return false, err
 	}
+	hash := sha256.Sum256(jsonTrainingData)
+	contentHash := hex.EncodeToString(hash[:])
 
-	if _, err := db.db.Query("REPLACE INTO edit_training_data (edit_id, training_data) VALUES (?, ?)", id, string(jsonTrainingData)); err != nil {
-		return err
-	}
+	// Invalid data is still stored so it shows in the report, but is quarantined from readers
+	status, reason := TrainingDataStatus(td.Validate())
 
-	return nil
-}
+	stored, err := encodeTrainingData(td)
+	if err != nil {
+		return false, err
+	}
 
-func (db *Db) GetTrainingDataEdits() (map[int]*TrainingData, error) {
-	results, err := db.db.Query("SELECT edit_id, training_data FROM edit_training_data")
+	ctx := context.Background()
+	tx, err := db.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	trainingDataEdits := map[int]*TrainingData{}
-	for results.Next() {
-		row := struct {
-			EditId       int
-			TrainingData []byte
-		}{}
-		if err := results.Scan(&row.EditId, &row.TrainingData); err != nil {
-			return nil, err
+	if status == TRAINING_DATA_INVALID {
+		var existingStatus int
+		err := tx.QueryRowContext(ctx, "SELECT validation_status FROM edit_training_data WHERE edit_id = ? FOR UPDATE", id).Scan(&existingStatus)
+		if err != nil && err != sql.ErrNoRows {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return false, err
+		}
+		if err == nil && existingStatus != TRAINING_DATA_INVALID {
+			if err := tx.Rollback(); err != nil {
+				log.Fatal(err)
+			}
+			return false, nil
 		}
+	}
 
-		trainingDataEdit := TrainingData{}
-		if err := json.Unmarshal(row.TrainingData, &trainingDataEdit); err != nil {
-			panic(err)
+	if err := stored.storeTrainingDataTexts(ctx, tx); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
 		}
-		trainingDataEdits[row.EditId] = &trainingDataEdit
+		return false, err
 	}
 
-	if err := results.Close(); err != nil {
-		return nil, err
+	// Unchanged content is only a newer fetch, so isn't worth keeping twice
+	if _, err := tx.ExecContext(ctx, "INSERT INTO edit_training_data_history (edit_id, encoding, training_data, current_text_hash, previous_text_hash, fetched, source, schema_version, content_hash) "+
+		"SELECT edit_id, encoding, training_data, current_text_hash, previous_text_hash, fetched, source, schema_version, content_hash FROM edit_training_data WHERE edit_id = ? AND content_hash != ?", id, contentHash); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return false, err
 	}
 
-	return trainingDataEdits, nil
+	if _, err := tx.ExecContext(ctx, "REPLACE INTO edit_training_data (edit_id, encoding, training_data, current_text_hash, previous_text_hash, fetched, source, schema_version, content_hash, validation_status, validation_reason) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		id, stored.Encoding, stored.Data, stored.CurrentTextHash, stored.PreviousTextHash, provenance.Fetched, provenance.Source, provenance.SchemaVersion, contentHash, status, truncateValidationReason(reason)); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, db.IncrementTrainingDataVersion()
+}
+
+func truncateValidationReason(reason string) string {
+	if len(reason) > 1024 {
+		return reason[:1021] + "..."
+	}
+	return reason
+}
+
+// QuarantineTrainingData marks stored training data as invalid, so it is no longer returned
+func (db *Db) QuarantineTrainingData(editId int, reason string) error {
+	if _, err := db.db.Exec("UPDATE edit_training_data SET validation_status = ?, validation_reason = ? WHERE edit_id = ?", TRAINING_DATA_INVALID, truncateValidationReason(reason), editId); err != nil {
+		return err
+	}
+	return db.IncrementTrainingDataVersion()
+}
+
+// decodeStoredTrainingData validates a stored row, quarantining it if invalid and migrating it if stored as plain JSON
+func (db *Db) decodeStoredTrainingData(stored *storedTrainingData) (*TrainingData, error) {
+	trainingData, status, reason := stored.decode()
+	if status == TRAINING_DATA_INVALID {
+		log.Printf("Quarantining training data for %d: %s", stored.EditId, reason)
+		if err := db.QuarantineTrainingData(stored.EditId, reason); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	if stored.Encoding == TRAINING_DATA_ENCODING_JSON {
+		if err := db.migrateTrainingData(stored.EditId, trainingData); err != nil {
+			return nil, err
+		}
+	}
+	return trainingData, nil
 }
 
 func (db *Db) GetTrainingDataByEditId(id int) (*TrainingData, error) {
-	results, err := db.db.Query("SELECT training_data FROM edit_training_data WHERE edit_id = ?", id)
+	results, err := db.db.Query("SELECT "+storedTrainingDataColumns+" FROM edit_training_data "+storedTrainingDataJoins+
+		" WHERE edit_training_data.edit_id = ? AND edit_training_data.validation_status != ?", id, TRAINING_DATA_INVALID)
 	if err != nil {
 		return nil, err
 	}
@@ -115,14 +184,78 @@ func (db *Db) GetTrainingDataByEditId(id int) (*TrainingData, error) {
 		return nil, nil
 	}
 
-	rawData := []byte{}
-	if err := results.Scan(&rawData); err != nil {
+	stored, err := scanStoredTrainingData(results)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return db.decodeStoredTrainingData(stored)
+}
+
+// TrainingDataCursor iterates over stored training data as it is read from the database
+type TrainingDataCursor struct {
+	db      *Db
+	results *sql.Rows
+}
+
+// Next returns the next valid row, quarantining any invalid rows it passes
+func (c *TrainingDataCursor) Next() (int, *TrainingData, error) {
+	for c.results.Next() {
+		stored, err := scanStoredTrainingData(c.results)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		trainingData, err := c.db.decodeStoredTrainingData(stored)
+		if err != nil {
+			return 0, nil, err
+		}
+		if trainingData != nil {
+			return stored.EditId, trainingData, nil
+		}
+	}
+	return 0, nil, c.results.Err()
+}
+
+func (c *TrainingDataCursor) Close() error {
+	return c.results.Close()
+}
+
+// StreamTrainingDataByGroupId returns the training data for a group ordered by edit id, matching StreamEditsByGroupId
+func (db *Db) StreamTrainingDataByGroupId(id int) (*TrainingDataCursor, error) {
+	results, err := db.db.Query("SELECT "+storedTrainingDataColumns+" "+
+		"FROM edit_training_data "+
+		"INNER JOIN edit_edit_group ON (edit_edit_group.edit_id = edit_training_data.edit_id) "+
+		storedTrainingDataJoins+" "+
+		"WHERE edit_edit_group.edit_group_id = ? AND edit_training_data.validation_status != ? "+
+		"ORDER BY edit_training_data.edit_id", id, TRAINING_DATA_INVALID)
+	if err != nil {
 		return nil, err
 	}
+	return &TrainingDataCursor{db: db, results: results}, nil
+}
 
-	trainingData := TrainingData{}
-	if err := json.Unmarshal(rawData, &trainingData); err != nil {
-		panic(err)
+// StreamTrainingData returns the training data after the edit id, ordered by edit id, optionally only for edits in the groups
+func (db *Db) StreamTrainingData(afterEditId int, editGroupIds []int) (*TrainingDataCursor, error) {
+	query := "SELECT " + storedTrainingDataColumns + " FROM edit_training_data " + storedTrainingDataJoins +
+		" WHERE edit_training_data.edit_id > ? AND edit_training_data.validation_status != ?"
+	args := []interface{}{afterEditId, TRAINING_DATA_INVALID}
+	if len(editGroupIds) > 0 {
+		placeholders := []string{}
+		for _, editGroupId := range editGroupIds {
+			placeholders = append(placeholders, "?")
+			args = append(args, editGroupId)
+		}
+		query += " AND EXISTS (SELECT 1 FROM edit_edit_group WHERE edit_edit_group.edit_id = edit_training_data.edit_id AND edit_edit_group.edit_group_id IN (" + strings.Join(placeholders, ", ") + "))"
+	}
+
+	results, err := db.db.Query(query+" ORDER BY edit_training_data.edit_id", args...)
+	if err != nil {
+		return nil, err
 	}
-	return &trainingData, nil
+	return &TrainingDataCursor{db: db, results: results}, nil
 }
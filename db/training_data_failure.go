@@ -0,0 +1,99 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"time"
+)
+
+// TrainingDataFailure tracks an edit whose training data could not be downloaded
+type TrainingDataFailure struct {
+	EditId     int       `json:"edit_id"`
+	Attempts   int       `json:"attempts"`
+	LastStatus int       `json:"last_status"`
+	LastError  string    `json:"last_error"`
+	NextRetry  time.Time `json:"next_retry"`
+	Updated    time.Time `json:"updated"`
+}
+
+func (db *Db) FetchAllTrainingDataFailures() (map[int]*TrainingDataFailure, error) {
+	results, err := db.db.Query("SELECT edit_id, attempts, last_status, last_error, next_retry, updated FROM training_data_failure")
+	if err != nil {
+		return nil, err
+	}
+
+	failures := map[int]*TrainingDataFailure{}
+	for results.Next() {
+		failure := &TrainingDataFailure{}
+		if err := results.Scan(&failure.EditId, &failure.Attempts, &failure.LastStatus, &failure.LastError, &failure.NextRetry, &failure.Updated); err != nil {
+			return nil, err
+		}
+		failures[failure.EditId] = failure
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return failures, nil
+}
+
+// RecordTrainingDataFailure stores a failed download, incrementing the attempts for the edit
+func (db *Db) RecordTrainingDataFailure(editId, status int, message string, nextRetry time.Time) error {
+	if _, err := db.db.Exec("INSERT INTO training_data_failure (edit_id, attempts, last_status, last_error, next_retry, updated) VALUES (?, 1, ?, ?, ?, NOW()) "+
+		"ON DUPLICATE KEY UPDATE attempts = attempts + 1, last_status = VALUES(last_status), last_error = VALUES(last_error), next_retry = VALUES(next_retry), updated = NOW()",
+		editId, status, message, nextRetry); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (db *Db) DeleteTrainingDataFailure(editId int) error {
+	if _, err := db.db.Exec("DELETE FROM training_data_failure WHERE edit_id = ?", editId); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LookupEditIdsWithTrainingData returns the edits with usable training data, without decoding it
+func (db *Db) LookupEditIdsWithTrainingData() (map[int]bool, error) {
+	results, err := db.db.Query("SELECT edit_id FROM edit_training_data WHERE validation_status != ?", TRAINING_DATA_INVALID)
+	if err != nil {
+		return nil, err
+	}
+
+	editIds := map[int]bool{}
+	for results.Next() {
+		var editId int
+		if err := results.Scan(&editId); err != nil {
+			return nil, err
+		}
+		editIds[editId] = true
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return editIds, nil
+}
@@ -0,0 +1,109 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"time"
+)
+
+// TrainingDataProvenance records where and when training data was fetched
+type TrainingDataProvenance struct {
+	Fetched       time.Time `json:"fetched"`
+	Source        string    `json:"source"`
+	SchemaVersion int       `json:"schema_version"`
+	ContentHash   string    `json:"content_hash"`
+}
+
+// TrainingDataVersion is a previous version of the training data for an edit
+type TrainingDataVersion struct {
+	TrainingDataProvenance
+	Id       int       `json:"id"`
+	Replaced time.Time `json:"replaced"`
+}
+
+func (db *Db) LookupTrainingDataProvenanceByEditId(id int) (*TrainingDataProvenance, error) {
+	results, err := db.db.Query("SELECT fetched, source, schema_version, content_hash FROM edit_training_data WHERE edit_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !results.Next() {
+		return nil, nil
+	}
+
+	provenance := &TrainingDataProvenance{}
+	if err := results.Scan(&provenance.Fetched, &provenance.Source, &provenance.SchemaVersion, &provenance.ContentHash); err != nil {
+		return nil, err
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return provenance, nil
+}
+
+func (db *Db) LookupTrainingDataHistoryByEditId(id int) ([]*TrainingDataVersion, error) {
+	results, err := db.db.Query("SELECT id, fetched, source, schema_version, content_hash, replaced FROM edit_training_data_history WHERE edit_id = ? ORDER BY id DESC", id)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []*TrainingDataVersion{}
+	for results.Next() {
+		version := &TrainingDataVersion{}
+		if err := results.Scan(&version.Id, &version.Fetched, &version.Source, &version.SchemaVersion, &version.ContentHash, &version.Replaced); err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// LookupStaleTrainingDataEditIds returns edits fetched before the time, or with an older schema version
+func (db *Db) LookupStaleTrainingDataEditIds(fetchedBefore time.Time, schemaVersion int) (map[int]bool, error) {
+	results, err := db.db.Query("SELECT edit_id FROM edit_training_data WHERE fetched < ? OR schema_version < ?", fetchedBefore, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	editIds := map[int]bool{}
+	for results.Next() {
+		var editId int
+		if err := results.Scan(&editId); err != nil {
+			return nil, err
+		}
+		editIds[editId] = true
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return editIds, nil
+}
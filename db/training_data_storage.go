@@ -0,0 +1,221 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+)
+
+// Selects a stored row with its texts, the table is expected to be joined with storedTrainingDataJoins
+const storedTrainingDataColumns = "edit_training_data.edit_id, edit_training_data.encoding, edit_training_data.training_data, " +
+	"edit_training_data.current_text_hash, current_text.content, edit_training_data.previous_text_hash, previous_text.content"
+
+const storedTrainingDataJoins = "LEFT JOIN training_data_text AS current_text ON (current_text.hash = edit_training_data.current_text_hash) " +
+	"LEFT JOIN training_data_text AS previous_text ON (previous_text.hash = edit_training_data.previous_text_hash)"
+
+// storedTrainingData is the training data as stored, the revision texts are kept separately so they are shared between edits
+type storedTrainingData struct {
+	EditId           int
+	Encoding         int
+	Data             []byte
+	CurrentTextHash  string
+	CurrentText      []byte
+	PreviousTextHash string
+	PreviousText     []byte
+}
+
+func scanStoredTrainingData(results *sql.Rows) (*storedTrainingData, error) {
+	stored := &storedTrainingData{}
+	if err := results.Scan(&stored.EditId, &stored.Encoding, &stored.Data, &stored.CurrentTextHash, &stored.CurrentText, &stored.PreviousTextHash, &stored.PreviousText); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func compressTrainingData(data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer := gzip.NewWriter(buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompressTrainingData(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+// hashTrainingDataText addresses a revision text by its content, empty text is not stored
+func hashTrainingDataText(text string) string {
+	if text == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
+
+// encodeTrainingData splits the revision texts out of the training data, then compresses the rest
+func encodeTrainingData(td *TrainingData) (*storedTrainingData, error) {
+	withoutText := *td
+	withoutText.Current.Text = ""
+	withoutText.Previous.Text = ""
+
+	jsonTrainingData, err := json.Marshal(withoutText)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := compressTrainingData(jsonTrainingData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storedTrainingData{
+		Encoding:         TRAINING_DATA_ENCODING_GZIP,
+		Data:             data,
+		CurrentTextHash:  hashTrainingDataText(td.Current.Text),
+		CurrentText:      []byte(td.Current.Text),
+		PreviousTextHash: hashTrainingDataText(td.Previous.Text),
+		PreviousText:     []byte(td.Previous.Text),
+	}, nil
+}
+
+// storeTrainingDataTexts saves the revision texts, texts already stored for another edit are left alone
+func (stored *storedTrainingData) storeTrainingDataTexts(ctx context.Context, tx *sql.Tx) error {
+	for _, text := range []struct {
+		hash    string
+		content []byte
+	}{
+		{stored.CurrentTextHash, stored.CurrentText},
+		{stored.PreviousTextHash, stored.PreviousText},
+	} {
+		if text.hash == "" {
+			continue
+		}
+
+		content, err := compressTrainingData(text.content)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "INSERT IGNORE INTO training_data_text (hash, content) VALUES (?, ?)", text.hash, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeText restores a revision text, a hash without content means the text was lost
+func decodeText(field, hash string, content []byte) (string, error) {
+	if hash == "" {
+		return "", nil
+	}
+	if content == nil {
+		return "", fmt.Errorf("%s: text %s not found", field, hash)
+	}
+
+	text, err := decompressTrainingData(content)
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", field, err)
+	}
+	return string(text), nil
+}
+
+// decode returns the training data with its texts, along with the validation status and reason
+func (stored *storedTrainingData) decode() (*TrainingData, int, string) {
+	switch stored.Encoding {
+	case TRAINING_DATA_ENCODING_JSON:
+		return DecodeTrainingData(stored.Data)
+
+	case TRAINING_DATA_ENCODING_GZIP:
+		rawData, err := decompressTrainingData(stored.Data)
+		if err != nil {
+			return nil, TRAINING_DATA_INVALID, fmt.Sprintf("decompress: %v", err)
+		}
+
+		trainingData := &TrainingData{}
+		if err := json.Unmarshal(rawData, trainingData); err != nil {
+			return nil, TRAINING_DATA_INVALID, fmt.Sprintf("decode: %v", err)
+		}
+
+		if trainingData.Current.Text, err = decodeText("current.text", stored.CurrentTextHash, stored.CurrentText); err != nil {
+			return nil, TRAINING_DATA_INVALID, err.Error()
+		}
+		if trainingData.Previous.Text, err = decodeText("previous.text", stored.PreviousTextHash, stored.PreviousText); err != nil {
+			return nil, TRAINING_DATA_INVALID, err.Error()
+		}
+
+		status, reason := TrainingDataStatus(trainingData.Validate())
+		return trainingData, status, reason
+	}
+	return nil, TRAINING_DATA_INVALID, fmt.Sprintf("unknown encoding: %d", stored.Encoding)
+}
+
+// migrateTrainingData re-encodes a row stored as plain JSON, the content hash is left as it was fetched
+func (db *Db) migrateTrainingData(editId int, td *TrainingData) error {
+	stored, err := encodeTrainingData(td)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := stored.storeTrainingDataTexts(ctx, tx); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return err
+	}
+
+	// Another reader may have migrated the row already
+	if _, err := tx.ExecContext(ctx, "UPDATE edit_training_data SET encoding = ?, training_data = ?, current_text_hash = ?, previous_text_hash = ? WHERE edit_id = ? AND encoding = ?",
+		stored.Encoding, stored.Data, stored.CurrentTextHash, stored.PreviousTextHash, editId, TRAINING_DATA_ENCODING_JSON); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,205 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Wikipedia launched on 2001-01-15, no edit or account can be older
+const trainingDataEarliestTimestamp = 979516800
+
+// TrainingDataIssue is a problem found validating training data, invalid issues stop it being used
+type TrainingDataIssue struct {
+	Field   string `json:"field"`
+	Reason  string `json:"reason"`
+	Invalid bool   `json:"invalid"`
+}
+
+func (i TrainingDataIssue) String() string {
+	return fmt.Sprintf("%s: %s", i.Field, i.Reason)
+}
+
+// Validate checks required fields, value ranges and the presence of the edit text
+func (td *TrainingData) Validate() []TrainingDataIssue {
+	issues := []TrainingDataIssue{}
+	invalid := func(field, reason string) {
+		issues = append(issues, TrainingDataIssue{Field: field, Reason: reason, Invalid: true})
+	}
+	partial := func(field, reason string) {
+		issues = append(issues, TrainingDataIssue{Field: field, Reason: reason})
+	}
+
+	if td.Current.Id <= 0 {
+		invalid("current.id", "missing")
+	}
+	if td.Current.User.Name == "" {
+		invalid("current.user.name", "missing")
+	}
+	if td.Page.Title == "" {
+		invalid("page.title", "missing")
+	}
+
+	latestTimestamp := int(time.Now().Add(24 * time.Hour).Unix())
+	if td.Current.Timestamp < trainingDataEarliestTimestamp || td.Current.Timestamp > latestTimestamp {
+		invalid("current.timestamp", fmt.Sprintf("out of range: %d", td.Current.Timestamp))
+	}
+	if td.Previous.Timestamp != 0 && (td.Previous.Timestamp < trainingDataEarliestTimestamp || td.Previous.Timestamp > td.Current.Timestamp) {
+		invalid("previous.timestamp", fmt.Sprintf("out of range: %d", td.Previous.Timestamp))
+	}
+	if td.Page.CreationTime != 0 && (td.Page.CreationTime < trainingDataEarliestTimestamp || td.Page.CreationTime > td.Current.Timestamp) {
+		invalid("page.creation_time", fmt.Sprintf("out of range: %d", td.Page.CreationTime))
+	}
+	// Anonymous and very old accounts have no registration time
+	if td.Current.User.RegistrationTime != 0 && (td.Current.User.RegistrationTime < trainingDataEarliestTimestamp || td.Current.User.RegistrationTime > latestTimestamp) {
+		invalid("current.user.registration_time", fmt.Sprintf("out of range: %d", td.Current.User.RegistrationTime))
+	}
+
+	for _, count := range []struct {
+		field string
+		value int
+	}{
+		{"current.user.edit_count", td.Current.User.EditCount},
+		{"current.user.distinct_pages_count", td.Current.User.DistinctPagesCount},
+		{"current.user.warning_count", td.Current.User.WarningCount},
+		{"page.recent_edit_count", td.Page.RecentEditCount},
+		{"page.recent_reversion_count", td.Page.RecentReversionCount},
+	} {
+		if count.value < 0 {
+			invalid(count.field, fmt.Sprintf("negative: %d", count.value))
+		}
+	}
+
+	// Text can be missing for deleted revisions, the metadata is still usable
+	if td.Current.Text == "" {
+		partial("current.text", "missing")
+	}
+	if td.Previous.Id > 0 && td.Previous.Text == "" {
+		partial("previous.text", "missing")
+	}
+	return issues
+}
+
+// DecodeTrainingData unmarshals and validates stored training data, returning the status and reason for it
+func DecodeTrainingData(raw []byte) (*TrainingData, int, string) {
+	trainingData := &TrainingData{}
+	if err := json.Unmarshal(raw, trainingData); err != nil {
+		return nil, TRAINING_DATA_INVALID, fmt.Sprintf("decode: %v", err)
+	}
+
+	status, reason := TrainingDataStatus(trainingData.Validate())
+	return trainingData, status, reason
+}
+
+// TrainingDataStatus summarises validation issues, the reason lists every issue found
+func TrainingDataStatus(issues []TrainingDataIssue) (int, string) {
+	status := TRAINING_DATA_VALID
+	reasons := []string{}
+	for _, issue := range issues {
+		reasons = append(reasons, issue.String())
+		if issue.Invalid {
+			status = TRAINING_DATA_INVALID
+		} else if status == TRAINING_DATA_VALID {
+			status = TRAINING_DATA_PARTIAL
+		}
+	}
+	return status, strings.Join(reasons, ", ")
+}
+
+// TrainingDataValidation is the stored validation result for the training data of an edit
+type TrainingDataValidation struct {
+	EditId  int       `json:"edit_id"`
+	Status  int       `json:"status"`
+	Reason  string    `json:"reason"`
+	Fetched time.Time `json:"fetched"`
+}
+
+// LookupTrainingDataIssues returns the partial and invalid training data, invalid first
+func (db *Db) LookupTrainingDataIssues() ([]*TrainingDataValidation, error) {
+	results, err := db.db.Query("SELECT edit_id, validation_status, validation_reason, fetched FROM edit_training_data WHERE validation_status != ? ORDER BY validation_status DESC, edit_id", TRAINING_DATA_VALID)
+	if err != nil {
+		return nil, err
+	}
+
+	validations := []*TrainingDataValidation{}
+	for results.Next() {
+		validation := &TrainingDataValidation{}
+		if err := results.Scan(&validation.EditId, &validation.Status, &validation.Reason, &validation.Fetched); err != nil {
+			return nil, err
+		}
+		validations = append(validations, validation)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return validations, nil
+}
+
+// RevalidateAllTrainingData re-runs validation over every stored row, returning the number changed
+func (db *Db) RevalidateAllTrainingData() (int, error) {
+	results, err := db.db.Query("SELECT " + storedTrainingDataColumns + ", edit_training_data.validation_status, edit_training_data.validation_reason FROM edit_training_data " + storedTrainingDataJoins)
+	if err != nil {
+		return 0, err
+	}
+
+	// Collect the changes first, so the updates don't need a second connection while reading
+	changed := []*TrainingDataValidation{}
+	for results.Next() {
+		stored := &storedTrainingData{}
+		var storedStatus int
+		var storedReason string
+		if err := results.Scan(&stored.EditId, &stored.Encoding, &stored.Data, &stored.CurrentTextHash, &stored.CurrentText, &stored.PreviousTextHash, &stored.PreviousText, &storedStatus, &storedReason); err != nil {
+			return 0, err
+		}
+
+		_, status, reason := stored.decode()
+		reason = truncateValidationReason(reason)
+		if status != storedStatus || reason != storedReason {
+			changed = append(changed, &TrainingDataValidation{EditId: stored.EditId, Status: status, Reason: reason})
+		}
+	}
+
+	if err := results.Close(); err != nil {
+		return 0, err
+	}
+
+	for _, validation := range changed {
+		if _, err := db.db.Exec("UPDATE edit_training_data SET validation_status = ?, validation_reason = ? WHERE edit_id = ?", validation.Status, validation.Reason, validation.EditId); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(changed) > 0 {
+		log.Printf("Revalidated training data, %d rows changed", len(changed))
+		if err := db.IncrementTrainingDataVersion(); err != nil {
+			return 0, err
+		}
+	}
+	return len(changed), nil
+}
@@ -28,6 +28,7 @@ type User struct {
 	Approved    bool   `json:"is_approved"`
 	Admin       bool   `json:"is_admin"`
 	LegacyCount int    `json:"legacy_count"`
+	Suspended   bool   `json:"is_suspended"`
 }
 
 type UserAccuracy struct {
@@ -44,11 +45,11 @@ func (db *Db) CreateUser(newUser User) error {
 	if err := insert.Close(); err != nil {
 		return err
 	}
-	return nil
+	return db.IncrementDataVersion()
 }
 
 func (db *Db) UpdateUser(id int, approved bool, admin bool) error {
-	update, err := db.db.Query("UPDATE users SET username=?, approved=?, admin=?)", approved, admin)
+	update, err := db.db.Query("UPDATE users SET approved = ?, admin = ? WHERE id = ?", approved, admin, id)
 	if err != nil {
 		return err
 	}
@@ -60,7 +61,7 @@ func (db *Db) UpdateUser(id int, approved bool, admin bool) error {
 }
 
 func (db *Db) LookupUserByName(username string) (*User, error) {
-	results, err := db.db.Query(`SELECT id, admin, approved, legacy_count FROM users WHERE username = ?`, username)
+	results, err := db.db.Query(`SELECT id, admin, approved, legacy_count, suspended FROM users WHERE username = ?`, username)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +71,7 @@ func (db *Db) LookupUserByName(username string) (*User, error) {
 	}
 
 	user := User{Username: username}
-	if err := results.Scan(&user.Id, &user.Admin, &user.Approved, &user.LegacyCount); err != nil {
+	if err := results.Scan(&user.Id, &user.Admin, &user.Approved, &user.LegacyCount, &user.Suspended); err != nil {
 		return nil, err
 	}
 
@@ -82,7 +83,7 @@ func (db *Db) LookupUserByName(username string) (*User, error) {
 }
 
 func (db *Db) LookupUserById(id int) (*User, error) {
-	results, err := db.db.Query(`SELECT username, admin, approved, legacy_count FROM users WHERE id = ?`, id)
+	results, err := db.db.Query(`SELECT username, admin, approved, legacy_count, suspended FROM users WHERE id = ?`, id)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +93,7 @@ func (db *Db) LookupUserById(id int) (*User, error) {
 	}
 
 	user := User{Id: id}
-	if err := results.Scan(&user.Username, &user.Admin, &user.Approved, &user.LegacyCount); err != nil {
+	if err := results.Scan(&user.Username, &user.Admin, &user.Approved, &user.LegacyCount, &user.Suspended); err != nil {
 		return nil, err
 	}
 
@@ -104,7 +105,7 @@ func (db *Db) LookupUserById(id int) (*User, error) {
 }
 
 func (db *Db) FetchAllUsers() ([]*User, error) {
-	results, err := db.db.Query("SELECT id, username, admin, approved, legacy_count FROM users")
+	results, err := db.db.Query("SELECT id, username, admin, approved, legacy_count, suspended FROM users")
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +113,7 @@ func (db *Db) FetchAllUsers() ([]*User, error) {
 	users := []*User{}
 	for results.Next() {
 		user := &User{}
-		if err := results.Scan(&user.Id, &user.Username, &user.Admin, &user.Approved, &user.LegacyCount); err != nil {
+		if err := results.Scan(&user.Id, &user.Username, &user.Admin, &user.Approved, &user.LegacyCount, &user.Suspended); err != nil {
 			return nil, err
 		}
 		users = append(users, user)
@@ -126,7 +127,7 @@ func (db *Db) FetchAllUsers() ([]*User, error) {
 }
 
 func (db *Db) CalculateTotalUserClassifications(user *User) (int, error) {
-	results, err := db.db.Query("SELECT COUNT(*) FROM user_classification WHERE user_id = ?", user.Id)
+	results, err := db.db.Query("SELECT COUNT(*) FROM user_classification WHERE user_id = ? AND quarantined = 0", user.Id)
 	if err != nil {
 		return -1, err
 	}
@@ -168,6 +169,10 @@ func (db *Db) CalculateUserClassificationAccuracy(user *User) (*UserAccuracy, er
 		}
 	}
 
+	if total == 0 {
+		return &UserAccuracy{}, nil
+	}
+
 	return &UserAccuracy{
 		EditCount:  total,
 		Percentage: (float32(correct) / float32(total)) * 100.00,
@@ -0,0 +1,158 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"time"
+)
+
+type UserGroupClassifications struct {
+	EditGroupId int    `json:"edit_group_id"`
+	Name        string `json:"name"`
+	Count       int    `json:"count"`
+}
+
+type UserDailyActivity struct {
+	Date  string `json:"date"`
+	Count int    `json:"count"`
+}
+
+type UserDisagreement struct {
+	EditId                 int    `json:"edit_id"`
+	Classification         int    `json:"classification"`
+	ReviewedClassification int    `json:"reviewed_classification"`
+	Comment                string `json:"comment"`
+}
+
+func (db *Db) CalculateUserClassificationsByGroup(user *User) ([]*UserGroupClassifications, error) {
+	results, err := db.db.Query("SELECT edit_group.id, edit_group.name, COUNT(DISTINCT user_classification.id) "+
+		"FROM user_classification "+
+		"INNER JOIN edit_edit_group ON (edit_edit_group.edit_id = user_classification.edit_id) "+
+		"INNER JOIN edit_group ON (edit_group.id = edit_edit_group.edit_group_id) "+
+		"WHERE user_classification.user_id = ? AND user_classification.quarantined = 0 "+
+		"GROUP BY edit_group.id, edit_group.name", user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := []*UserGroupClassifications{}
+	for results.Next() {
+		group := &UserGroupClassifications{}
+		if err := results.Scan(&group.EditGroupId, &group.Name, &group.Count); err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+func (db *Db) LookupRecentUserClassificationsByUserId(id, limit int) ([]*UserClassification, error) {
+	results, err := db.db.Query("SELECT id, user_id, comment, classification, edit_id, created FROM user_classification WHERE user_id = ? AND quarantined = 0 ORDER BY created DESC, id DESC LIMIT ?", id, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	classifications := []*UserClassification{}
+	for results.Next() {
+		c := &UserClassification{}
+		if err := results.Scan(&c.Id, &c.UserId, &c.Comment, &c.Classification, &c.EditId, &c.Created); err != nil {
+			return nil, err
+		}
+		classifications = append(classifications, c)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return classifications, nil
+}
+
+func (db *Db) CalculateUserDailyActivity(user *User, days int) ([]*UserDailyActivity, error) {
+	since := time.Now().UTC().AddDate(0, 0, -(days - 1)).Truncate(24 * time.Hour)
+	results, err := db.db.Query("SELECT DATE_FORMAT(created, '%Y-%m-%d'), COUNT(*) FROM user_classification "+
+		"WHERE user_id = ? AND quarantined = 0 AND created >= ? GROUP BY DATE_FORMAT(created, '%Y-%m-%d')", user.Id, since)
+	if err != nil {
+		return nil, err
+	}
+
+	countsByDate := map[string]int{}
+	for results.Next() {
+		var date string
+		var count int
+		if err := results.Scan(&date, &count); err != nil {
+			return nil, err
+		}
+		countsByDate[date] = count
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	// Include the days without any activity so the series is continuous
+	activity := []*UserDailyActivity{}
+	for day := 0; day < days; day++ {
+		date := since.AddDate(0, 0, day).Format("2006-01-02")
+		activity = append(activity, &UserDailyActivity{Date: date, Count: countsByDate[date]})
+	}
+	return activity, nil
+}
+
+func (db *Db) CalculateUserClassificationDisagreements(user *User) ([]*UserDisagreement, error) {
+	userClassifications, err := db.LookupUserClassificationsByUserId(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	disagreements := []*UserDisagreement{}
+	for _, userClassification := range userClassifications {
+		edit, err := db.LookupEditById(userClassification.EditId)
+		if err != nil {
+			return nil, err
+		}
+		if edit == nil {
+			continue
+		}
+
+		reviewedClassification := edit.ReviewedClassification()
+		if reviewedClassification == EDIT_CLASSIFICATION_UNKNOWN || reviewedClassification == EDIT_CLASSIFICATION_SKIPPED {
+			continue
+		}
+		if reviewedClassification != userClassification.Classification {
+			disagreements = append(disagreements, &UserDisagreement{
+				EditId:                 edit.Id,
+				Classification:         userClassification.Classification,
+				ReviewedClassification: reviewedClassification,
+				Comment:                userClassification.Comment,
+			})
+		}
+	}
+
+	return disagreements, nil
+}
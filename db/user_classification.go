@@ -22,12 +22,17 @@ package db
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
 // SOFTWARE.
 
+import (
+	"time"
+)
+
 type UserClassification struct {
 	Id             int
 	UserId         int
 	Comment        string
 	Classification int
 	EditId         int
+	Created        time.Time
 }
 
 func (db *Db) CreateUserClassification(newUserClassification UserClassification) error {
@@ -39,11 +44,11 @@ func (db *Db) CreateUserClassification(newUserClassification UserClassification)
 	if err := insert.Close(); err != nil {
 		return err
 	}
-	return nil
+	return db.IncrementDataVersion()
 }
 
 func (db *Db) LookupUserClassificationsById(id int) (*UserClassification, error) {
-	results, err := db.db.Query("SELECT id, user_id, comment, classification, edit_id FROM user_classification WHERE id = ?", id)
+	results, err := db.db.Query("SELECT id, user_id, comment, classification, edit_id, created FROM user_classification WHERE id = ?", id)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +58,7 @@ func (db *Db) LookupUserClassificationsById(id int) (*UserClassification, error)
 	}
 
 	c := &UserClassification{}
-	if err := results.Scan(&c.Id, &c.UserId, &c.Comment, &c.Classification, &c.EditId); err != nil {
+	if err := results.Scan(&c.Id, &c.UserId, &c.Comment, &c.Classification, &c.EditId, &c.Created); err != nil {
 		return nil, err
 	}
 
@@ -65,7 +70,7 @@ func (db *Db) LookupUserClassificationsById(id int) (*UserClassification, error)
 }
 
 func (db *Db) LookupUserClassificationsByEditId(id int) ([]*UserClassification, error) {
-	results, err := db.db.Query("SELECT id, user_id, comment, classification, edit_id FROM user_classification WHERE edit_id = ?", id)
+	results, err := db.db.Query("SELECT id, user_id, comment, classification, edit_id, created FROM user_classification WHERE edit_id = ? AND quarantined = 0", id)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +78,7 @@ func (db *Db) LookupUserClassificationsByEditId(id int) ([]*UserClassification,
 	classifications := []*UserClassification{}
 	for results.Next() {
 		c := &UserClassification{}
-		if err := results.Scan(&c.Id, &c.UserId, &c.Comment, &c.Classification, &c.EditId); err != nil {
+		if err := results.Scan(&c.Id, &c.UserId, &c.Comment, &c.Classification, &c.EditId, &c.Created); err != nil {
 			return nil, err
 		}
 		classifications = append(classifications, c)
@@ -87,7 +92,7 @@ func (db *Db) LookupUserClassificationsByEditId(id int) ([]*UserClassification,
 }
 
 func (db *Db) LookupUserClassificationsByUserId(id int) ([]*UserClassification, error) {
-	results, err := db.db.Query("SELECT id, user_id, comment, classification, edit_id FROM user_classification WHERE user_id = ?", id)
+	results, err := db.db.Query("SELECT id, user_id, comment, classification, edit_id, created FROM user_classification WHERE user_id = ? AND quarantined = 0", id)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +100,7 @@ func (db *Db) LookupUserClassificationsByUserId(id int) ([]*UserClassification,
 	classifications := []*UserClassification{}
 	for results.Next() {
 		c := &UserClassification{}
-		if err := results.Scan(&c.Id, &c.UserId, &c.Comment, &c.Classification, &c.EditId); err != nil {
+		if err := results.Scan(&c.Id, &c.UserId, &c.Comment, &c.Classification, &c.EditId, &c.Created); err != nil {
 			return nil, err
 		}
 		classifications = append(classifications, c)
@@ -109,7 +114,7 @@ func (db *Db) LookupUserClassificationsByUserId(id int) ([]*UserClassification,
 }
 
 func (db *Db) FetchAllUserClassifications() ([]*UserClassification, error) {
-	results, err := db.db.Query("SELECT id, user_id, comment, classification, edit_id FROM user_classification")
+	results, err := db.db.Query("SELECT id, user_id, comment, classification, edit_id, created FROM user_classification WHERE quarantined = 0")
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +122,7 @@ func (db *Db) FetchAllUserClassifications() ([]*UserClassification, error) {
 	classifications := []*UserClassification{}
 	for results.Next() {
 		c := &UserClassification{}
-		if err := results.Scan(&c.Id, &c.UserId, &c.Comment, &c.Classification, &c.EditId); err != nil {
+		if err := results.Scan(&c.Id, &c.UserId, &c.Comment, &c.Classification, &c.EditId, &c.Created); err != nil {
 			return nil, err
 		}
 		classifications = append(classifications, c)
@@ -0,0 +1,140 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"context"
+	"log"
+)
+
+type SuspensionImpact struct {
+	EditId                int `json:"edit_id"`
+	CurrentClassification int `json:"current_classification"`
+	NewClassification     int `json:"new_classification"`
+}
+
+type SuspensionPreview struct {
+	Classifications int                 `json:"classifications"`
+	Changed         []*SuspensionImpact `json:"changed"`
+}
+
+func (db *Db) CalculateUserSuspensionImpact(user *User) (*SuspensionPreview, error) {
+	userClassifications, err := db.LookupUserClassificationsByUserId(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &SuspensionPreview{Classifications: len(userClassifications), Changed: []*SuspensionImpact{}}
+	for _, userClassification := range userClassifications {
+		edit, err := db.LookupEditById(userClassification.EditId)
+		if err != nil {
+			return nil, err
+		}
+		if edit == nil {
+			continue
+		}
+
+		// Re-calculate the consensus as if this vote did not exist
+		withoutUser := *edit
+		switch userClassification.Classification {
+		case EDIT_CLASSIFICATION_VANDALISM:
+			withoutUser.UserClassificationsVandalism -= 1
+		case EDIT_CLASSIFICATION_CONSTRUCTIVE:
+			withoutUser.UserClassificationsConstructive -= 1
+		case EDIT_CLASSIFICATION_SKIPPED:
+			withoutUser.UserClassificationsSkipped -= 1
+		}
+
+		if edit.ReviewedClassification() != withoutUser.ReviewedClassification() {
+			preview.Changed = append(preview.Changed, &SuspensionImpact{
+				EditId:                edit.Id,
+				CurrentClassification: edit.ReviewedClassification(),
+				NewClassification:     withoutUser.ReviewedClassification(),
+			})
+		}
+	}
+
+	return preview, nil
+}
+
+func (db *Db) SuspendUser(id int) ([]*EditLabelChange, error) {
+	ctx := context.Background()
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE users SET approved = 0, suspended = 1 WHERE id = ?", id); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return nil, err
+	}
+
+	// Quarantined classifications are excluded from consensus, so any edit losing it re-enters the queue
+	if _, err := tx.ExecContext(ctx, "UPDATE user_classification SET quarantined = 1 WHERE user_id = ?", id); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	if err := db.IncrementDataVersion(); err != nil {
+		return nil, err
+	}
+	return db.RecordEditLabelsForUser(id)
+}
+
+func (db *Db) UnsuspendUser(id int) ([]*EditLabelChange, error) {
+	ctx := context.Background()
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Approval is left for an admin to grant again
+	if _, err := tx.ExecContext(ctx, "UPDATE users SET suspended = 0 WHERE id = ?", id); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE user_classification SET quarantined = 0 WHERE user_id = ?", id); err != nil {
+		if err := tx.Rollback(); err != nil {
+			log.Fatal(err)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	if err := db.IncrementDataVersion(); err != nil {
+		return nil, err
+	}
+	return db.RecordEditLabelsForUser(id)
+}
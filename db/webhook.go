@@ -0,0 +1,122 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"strings"
+	"time"
+)
+
+// Webhook is a subscription to review events, delivered to the url signed with the secret
+type Webhook struct {
+	Id      int
+	Url     string
+	Secret  string
+	Events  []string
+	Created time.Time
+}
+
+func (w *Webhook) Subscribes(event string) bool {
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+func (db *Db) CreateWebhook(url, secret string, events []string) error {
+	if _, err := db.db.Exec("INSERT INTO webhook (url, secret, events) VALUES (?, ?, ?)", url, secret, strings.Join(events, ",")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteWebhook removes the subscription, any pending deliveries are no longer sent
+func (db *Db) DeleteWebhook(id int) (bool, error) {
+	result, err := db.db.Exec("DELETE FROM webhook WHERE id = ?", id)
+	if err != nil {
+		return false, err
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected != 1 {
+		return false, err
+	}
+	return true, nil
+}
+
+func scanWebhook(scan func(dest ...interface{}) error) (*Webhook, error) {
+	w := &Webhook{}
+	var events string
+	if err := scan(&w.Id, &w.Url, &w.Secret, &events, &w.Created); err != nil {
+		return nil, err
+	}
+	w.Events = []string{}
+	if events != "" {
+		w.Events = strings.Split(events, ",")
+	}
+	return w, nil
+}
+
+func (db *Db) LookupWebhookById(id int) (*Webhook, error) {
+	results, err := db.db.Query("SELECT id, url, secret, events, created FROM webhook WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !results.Next() {
+		return nil, nil
+	}
+
+	w, err := scanWebhook(results.Scan)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (db *Db) FetchAllWebhooks() ([]*Webhook, error) {
+	results, err := db.db.Query("SELECT id, url, secret, events, created FROM webhook ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := []*Webhook{}
+	for results.Next() {
+		w, err := scanWebhook(results.Scan)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
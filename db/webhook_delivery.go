@@ -0,0 +1,134 @@
+package db
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"database/sql"
+	"time"
+)
+
+// WebhookDelivery is a queued event for a webhook, along with the outcome of the last attempt
+type WebhookDelivery struct {
+	Id           int
+	WebhookId    int
+	Url          string
+	Secret       string
+	Event        string
+	Payload      string
+	Status       int
+	Attempts     int
+	NextAttempt  time.Time
+	LastAttempt  *time.Time
+	ResponseCode int
+	Error        string
+	Created      time.Time
+}
+
+// CreateWebhookDeliveries queues the event for every webhook subscribed to it, returning the number queued
+func (db *Db) CreateWebhookDeliveries(event, payload string) (int, error) {
+	result, err := db.db.Exec("INSERT INTO webhook_delivery (webhook_id, event, payload) "+
+		"SELECT id, ?, ? FROM webhook WHERE FIND_IN_SET(?, events) > 0", event, payload, event)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
+// CreateWebhookDelivery queues the event for one webhook, regardless of its subscriptions
+func (db *Db) CreateWebhookDelivery(webhookId int, event, payload string) error {
+	if _, err := db.db.Exec("INSERT INTO webhook_delivery (webhook_id, event, payload) VALUES (?, ?, ?)", webhookId, event, payload); err != nil {
+		return err
+	}
+	return nil
+}
+
+const webhookDeliveryColumns = "webhook_delivery.id, webhook_delivery.webhook_id, webhook.url, webhook.secret, " +
+	"webhook_delivery.event, webhook_delivery.payload, webhook_delivery.status, webhook_delivery.attempts, " +
+	"webhook_delivery.next_attempt, webhook_delivery.last_attempt, webhook_delivery.response_code, " +
+	"webhook_delivery.error, webhook_delivery.created"
+
+func (db *Db) lookupWebhookDeliveries(query string, args ...interface{}) ([]*WebhookDelivery, error) {
+	results, err := db.db.Query("SELECT "+webhookDeliveryColumns+" FROM webhook_delivery "+
+		"INNER JOIN webhook ON (webhook.id = webhook_delivery.webhook_id) "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := []*WebhookDelivery{}
+	for results.Next() {
+		d := &WebhookDelivery{}
+		var lastAttempt sql.NullTime
+		if err := results.Scan(&d.Id, &d.WebhookId, &d.Url, &d.Secret, &d.Event, &d.Payload, &d.Status, &d.Attempts, &d.NextAttempt, &lastAttempt, &d.ResponseCode, &d.Error, &d.Created); err != nil {
+			return nil, err
+		}
+		if lastAttempt.Valid {
+			d.LastAttempt = &lastAttempt.Time
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err := results.Close(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// LookupDueWebhookDeliveries returns pending deliveries whose next attempt has passed, oldest first
+func (db *Db) LookupDueWebhookDeliveries(limit int) ([]*WebhookDelivery, error) {
+	return db.lookupWebhookDeliveries("WHERE webhook_delivery.status = ? AND webhook_delivery.next_attempt <= NOW() "+
+		"ORDER BY webhook_delivery.id LIMIT ?", WEBHOOK_DELIVERY_PENDING, limit)
+}
+
+func (db *Db) LookupRecentWebhookDeliveries(limit int) ([]*WebhookDelivery, error) {
+	return db.lookupWebhookDeliveries("ORDER BY webhook_delivery.id DESC LIMIT ?", limit)
+}
+
+// RecordWebhookDeliveryAttempt stores the outcome of an attempt, retryIn is the delay before the next one while pending
+func (db *Db) RecordWebhookDeliveryAttempt(id, status, responseCode int, deliveryError string, retryIn time.Duration) error {
+	if len(deliveryError) > 1024 {
+		deliveryError = deliveryError[:1021] + "..."
+	}
+	if _, err := db.db.Exec("UPDATE webhook_delivery SET status = ?, attempts = attempts + 1, response_code = ?, error = ?, "+
+		"last_attempt = NOW(), next_attempt = NOW() + INTERVAL ? SECOND WHERE id = ?",
+		status, responseCode, deliveryError, int(retryIn.Seconds()), id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RedeliverWebhookDelivery queues the delivery again, starting over its attempts
+func (db *Db) RedeliverWebhookDelivery(id int) (bool, error) {
+	result, err := db.db.Exec("UPDATE webhook_delivery SET status = ?, attempts = 0, next_attempt = NOW() WHERE id = ?", WEBHOOK_DELIVERY_PENDING, id)
+	if err != nil {
+		return false, err
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected != 1 {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,265 @@
+package diff
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"strings"
+	"unicode"
+)
+
+type Op int
+
+const (
+	Equal Op = iota
+	Delete
+	Insert
+)
+
+// Chunk is a run of text which is unchanged, deleted from the previous text or inserted into the current text
+type Chunk struct {
+	Op   Op
+	Text string
+}
+
+// Lines and words differing by more than this are treated as entirely replaced, bounding the time and memory used
+const maxEdits = 1000
+
+// Words diffs the texts by line, then by word within each changed block of lines
+func Words(previous, current string) []Chunk {
+	builder := &chunkBuilder{}
+	deleted, inserted := []string{}, []string{}
+	flush := func() {
+		if len(deleted) > 0 && len(inserted) > 0 {
+			builder.addOps(tokenizeWords(strings.Join(deleted, "")), tokenizeWords(strings.Join(inserted, "")))
+		} else {
+			builder.add(Delete, strings.Join(deleted, ""))
+			builder.add(Insert, strings.Join(inserted, ""))
+		}
+		deleted, inserted = deleted[:0], inserted[:0]
+	}
+
+	previousLines, currentLines := tokenizeLines(previous), tokenizeLines(current)
+	x, y := 0, 0
+	for _, op := range diffTokens(previousLines, currentLines) {
+		switch op {
+		case Equal:
+			flush()
+			builder.add(Equal, previousLines[x])
+			x++
+			y++
+		case Delete:
+			deleted = append(deleted, previousLines[x])
+			x++
+		case Insert:
+			inserted = append(inserted, currentLines[y])
+			y++
+		}
+	}
+	flush()
+	return builder.finish()
+}
+
+// chunkBuilder merges consecutive text with the same operation into one chunk
+type chunkBuilder struct {
+	chunks []Chunk
+	op     Op
+	text   strings.Builder
+}
+
+func (b *chunkBuilder) add(op Op, text string) {
+	if text == "" {
+		return
+	}
+	if b.text.Len() > 0 && b.op != op {
+		b.chunks = append(b.chunks, Chunk{Op: b.op, Text: b.text.String()})
+		b.text.Reset()
+	}
+	b.op = op
+	b.text.WriteString(text)
+}
+
+// addOps diffs the tokens, adding the result
+func (b *chunkBuilder) addOps(previous, current []string) {
+	x, y := 0, 0
+	for _, op := range diffTokens(previous, current) {
+		switch op {
+		case Equal:
+			b.add(Equal, previous[x])
+			x++
+			y++
+		case Delete:
+			b.add(Delete, previous[x])
+			x++
+		case Insert:
+			b.add(Insert, current[y])
+			y++
+		}
+	}
+}
+
+func (b *chunkBuilder) finish() []Chunk {
+	chunks := b.chunks
+	if b.text.Len() > 0 {
+		chunks = append(chunks, Chunk{Op: b.op, Text: b.text.String()})
+	}
+	return chunks
+}
+
+func tokenizeLines(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+	return strings.SplitAfter(text, "\n")
+}
+
+// tokenizeWords splits text into runs of letters and digits, runs of spaces and single other characters
+func tokenizeWords(text string) []string {
+	tokens := []string{}
+	start := 0
+	runes := []rune(text)
+	class := func(r rune) int {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return 1
+		}
+		if unicode.IsSpace(r) {
+			return 2
+		}
+		return 0
+	}
+	for i := 1; i <= len(runes); i++ {
+		if i == len(runes) || class(runes[i]) == 0 || class(runes[i]) != class(runes[start]) {
+			tokens = append(tokens, string(runes[start:i]))
+			start = i
+		}
+	}
+	return tokens
+}
+
+// diffTokens returns the operations turning a into b, skipping the common prefix and suffix before diffing
+func diffTokens(a, b []string) []Op {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]Op, 0, len(a)+len(b))
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, Equal)
+	}
+
+	middleA, middleB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if middleOps, ok := myers(middleA, middleB); ok {
+		ops = append(ops, middleOps...)
+	} else {
+		for range middleA {
+			ops = append(ops, Delete)
+		}
+		for range middleB {
+			ops = append(ops, Insert)
+		}
+	}
+
+	for i := 0; i < suffix; i++ {
+		ops = append(ops, Equal)
+	}
+	return ops
+}
+
+// myers finds the shortest edit script, failing if more than maxEdits are needed
+func myers(a, b []string) ([]Op, bool) {
+	n, m := len(a), len(b)
+	limit := n + m
+	if limit > maxEdits {
+		limit = maxEdits
+	}
+
+	// v holds the furthest x reached on each diagonal k, offset so k can be negative
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	trace := [][]int{}
+	for d := 0; d <= limit; d++ {
+		// Only the diagonals the next step can read are kept for the backtrack
+		trace = append(trace, append([]int{}, v[offset-d-1:offset+d+2]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, n, m), true
+			}
+		}
+	}
+	return nil, false
+}
+
+func backtrack(trace [][]int, n, m int) []Op {
+	ops := []Op{}
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := func(k int) int { return trace[d][k+d+1] }
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v(k-1) < v(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			ops = append(ops, Equal)
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, Insert)
+			} else {
+				ops = append(ops, Delete)
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
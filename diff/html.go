@@ -0,0 +1,67 @@
+package diff
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// HTML renders the chunks with ins and del elements, unchanged text is cut down to contextLines around each change
+func HTML(chunks []Chunk, contextLines int) string {
+	sb := strings.Builder{}
+	for i, chunk := range chunks {
+		switch chunk.Op {
+		case Delete:
+			sb.WriteString(`<del class="diff-deleted">` + html.EscapeString(chunk.Text) + `</del>`)
+		case Insert:
+			sb.WriteString(`<ins class="diff-added">` + html.EscapeString(chunk.Text) + `</ins>`)
+		case Equal:
+			writeContext(&sb, chunk.Text, i > 0, i < len(chunks)-1, contextLines)
+		}
+	}
+	return sb.String()
+}
+
+// writeContext writes unchanged text, keeping the lines following the previous change and leading to the next
+func writeContext(sb *strings.Builder, text string, afterChange, beforeChange bool, contextLines int) {
+	lines := strings.SplitAfter(text, "\n")
+	head, tail := 0, 0
+	if afterChange {
+		head = contextLines + 1
+	}
+	if beforeChange {
+		tail = contextLines + 1
+	}
+
+	if head+tail >= len(lines) {
+		sb.WriteString(html.EscapeString(text))
+		return
+	}
+
+	sb.WriteString(html.EscapeString(strings.Join(lines[:head], "")))
+	skipped := len(lines) - head - tail
+	sb.WriteString(fmt.Sprintf("<span class=\"diff-skipped\">%d unchanged lines</span>\n", skipped))
+	sb.WriteString(html.EscapeString(strings.Join(lines[len(lines)-tail:], "")))
+}
@@ -0,0 +1,130 @@
+package export
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"github.com/cluebotng/reviewng/features"
+	"io"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	RegisterFormat(&Format{
+		Name:              "arff",
+		ContentType:       "text/plain; charset=UTF-8",
+		Extension:         "arff",
+		NeedsTrainingData: true,
+		New: func(w io.Writer) Exporter {
+			return &arffExporter{writer: w}
+		},
+	})
+}
+
+type arffFeature struct {
+	Name  string
+	Value func(td *db.TrainingData) int
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+func formatFeature(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// arffFeatures are the numeric attributes taken from the stored training data
+var arffFeatures = []arffFeature{
+	{"user_edit_count", func(td *db.TrainingData) int { return td.Current.User.EditCount }},
+	{"user_distinct_pages", func(td *db.TrainingData) int { return td.Current.User.DistinctPagesCount }},
+	{"user_warns", func(td *db.TrainingData) int { return td.Current.User.WarningCount }},
+	{"user_reg_time", func(td *db.TrainingData) int { return td.Current.User.RegistrationTime }},
+	{"page_made_time", func(td *db.TrainingData) int { return td.Page.CreationTime }},
+	{"num_recent_edits", func(td *db.TrainingData) int { return td.Page.RecentEditCount }},
+	{"num_recent_reversions", func(td *db.TrainingData) int { return td.Page.RecentReversionCount }},
+	{"current_minor", func(td *db.TrainingData) int { return boolToInt(td.Current.Minor) }},
+	{"current_timestamp", func(td *db.TrainingData) int { return td.Current.Timestamp }},
+	{"previous_timestamp", func(td *db.TrainingData) int { return td.Previous.Timestamp }},
+	{"comment_length", func(td *db.TrainingData) int { return len(td.Current.Comment) }},
+	{"current_text_length", func(td *db.TrainingData) int { return len(td.Current.Text) }},
+	{"previous_text_length", func(td *db.TrainingData) int { return len(td.Previous.Text) }},
+	{"previous_user_is_current_user", func(td *db.TrainingData) int { return boolToInt(td.Previous.User.Name == td.Current.User.Name) }},
+}
+
+type arffExporter struct {
+	writer io.Writer
+}
+
+func (e *arffExporter) WriteHeader() error {
+	header := []string{
+		"@RELATION reviewng",
+		"",
+		"@ATTRIBUTE edit_id NUMERIC",
+		"@ATTRIBUTE edit_group_id NUMERIC",
+	}
+	for _, feature := range arffFeatures {
+		header = append(header, fmt.Sprintf("@ATTRIBUTE %s NUMERIC", feature.Name))
+	}
+	for _, name := range features.Names() {
+		header = append(header, fmt.Sprintf("@ATTRIBUTE %s NUMERIC", name))
+	}
+	header = append(header, "@ATTRIBUTE class {V,C,S,U}", "", "@DATA", "")
+
+	_, err := io.WriteString(e.writer, strings.Join(header, "\n"))
+	return err
+}
+
+func (e *arffExporter) WriteRow(row *Row) error {
+	values := []string{strconv.Itoa(row.EditId), strconv.Itoa(row.EditGroupId)}
+	for _, feature := range arffFeatures {
+		// Edits without stored training data have missing values
+		if row.TrainingData == nil {
+			values = append(values, "?")
+		} else {
+			values = append(values, strconv.Itoa(feature.Value(row.TrainingData)))
+		}
+	}
+	if row.TrainingData == nil {
+		for range features.Names() {
+			values = append(values, "?")
+		}
+	} else {
+		for _, value := range features.Extract(row.TrainingData) {
+			values = append(values, formatFeature(value))
+		}
+	}
+	values = append(values, row.ReviewedClassification)
+
+	_, err := fmt.Fprintln(e.writer, strings.Join(values, ","))
+	return err
+}
+
+func (e *arffExporter) Close() error {
+	return nil
+}
@@ -0,0 +1,79 @@
+package export
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+func init() {
+	RegisterFormat(&Format{
+		Name:        "csv",
+		ContentType: "text/csv; charset=UTF-8",
+		Extension:   "csv",
+		New: func(w io.Writer) Exporter {
+			return &csvExporter{writer: csv.NewWriter(w)}
+		},
+	})
+}
+
+type csvExporter struct {
+	writer *csv.Writer
+}
+
+func (e *csvExporter) WriteHeader() error {
+	return e.writer.Write([]string{
+		"edit_id",
+		"edit_group_id",
+		"edit_group",
+		"weight",
+		"required",
+		"constructive",
+		"skipped",
+		"vandalism",
+		"original_classification",
+		"reviewed_classification",
+	})
+}
+
+func (e *csvExporter) WriteRow(row *Row) error {
+	return e.writer.Write([]string{
+		strconv.Itoa(row.EditId),
+		strconv.Itoa(row.EditGroupId),
+		row.EditGroup,
+		strconv.Itoa(row.Weight),
+		strconv.Itoa(row.Required),
+		strconv.Itoa(row.Constructive),
+		strconv.Itoa(row.Skipped),
+		strconv.Itoa(row.Vandalism),
+		row.OriginalClassification,
+		row.ReviewedClassification,
+	})
+}
+
+func (e *csvExporter) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
@@ -0,0 +1,83 @@
+package export
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"github.com/cluebotng/reviewng/db"
+	"io"
+	"sort"
+)
+
+// Row is a single edit flattened for a dataset export
+type Row struct {
+	EditId                 int              `json:"edit_id"`
+	EditGroupId            int              `json:"edit_group_id"`
+	EditGroup              string           `json:"edit_group"`
+	Weight                 int              `json:"weight"`
+	Required               int              `json:"required"`
+	Constructive           int              `json:"constructive"`
+	Skipped                int              `json:"skipped"`
+	Vandalism              int              `json:"vandalism"`
+	OriginalClassification string           `json:"original_classification"`
+	ReviewedClassification string           `json:"reviewed_classification"`
+	TrainingData           *db.TrainingData `json:"-"`
+}
+
+// Exporter writes rows to an underlying writer in a single format
+type Exporter interface {
+	WriteHeader() error
+	WriteRow(row *Row) error
+	Close() error
+}
+
+// Format describes a registered exporter
+type Format struct {
+	Name        string
+	ContentType string
+	Extension   string
+	// Set when rows must have their stored training data attached
+	NeedsTrainingData bool
+	New               func(w io.Writer) Exporter
+}
+
+var formats = map[string]*Format{}
+
+func RegisterFormat(format *Format) {
+	formats[format.Name] = format
+}
+
+func LookupFormat(name string) *Format {
+	if format, ok := formats[name]; ok {
+		return format
+	}
+	return nil
+}
+
+func FormatNames() []string {
+	names := []string{}
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,80 @@
+package export
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/csv"
+	"github.com/cluebotng/reviewng/features"
+	"io"
+	"strconv"
+)
+
+func init() {
+	RegisterFormat(&Format{
+		Name:              "features",
+		ContentType:       "text/csv; charset=UTF-8",
+		Extension:         "csv",
+		NeedsTrainingData: true,
+		New: func(w io.Writer) Exporter {
+			return &featuresExporter{writer: csv.NewWriter(w)}
+		},
+	})
+}
+
+// featuresExporter writes the derived features of each edit as CSV, for comparing them across labels
+type featuresExporter struct {
+	writer *csv.Writer
+}
+
+func (e *featuresExporter) WriteHeader() error {
+	return e.writer.Write(append([]string{
+		"edit_id",
+		"edit_group_id",
+		"reviewed_classification",
+	}, features.Names()...))
+}
+
+func (e *featuresExporter) WriteRow(row *Row) error {
+	values := []string{
+		strconv.Itoa(row.EditId),
+		strconv.Itoa(row.EditGroupId),
+		row.ReviewedClassification,
+	}
+
+	// Edits without stored training data are left empty
+	if row.TrainingData == nil {
+		for range features.Names() {
+			values = append(values, "")
+		}
+	} else {
+		for _, value := range features.Extract(row.TrainingData) {
+			values = append(values, formatFeature(value))
+		}
+	}
+	return e.writer.Write(values)
+}
+
+func (e *featuresExporter) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
@@ -0,0 +1,56 @@
+package export
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/json"
+	"io"
+)
+
+func init() {
+	RegisterFormat(&Format{
+		Name:        "jsonl",
+		ContentType: "application/x-ndjson; charset=UTF-8",
+		Extension:   "jsonl",
+		New: func(w io.Writer) Exporter {
+			return &jsonlExporter{encoder: json.NewEncoder(w)}
+		},
+	})
+}
+
+type jsonlExporter struct {
+	encoder *json.Encoder
+}
+
+func (e *jsonlExporter) WriteHeader() error {
+	return nil
+}
+
+func (e *jsonlExporter) WriteRow(row *Row) error {
+	// Encode terminates each value with a newline
+	return e.encoder.Encode(row)
+}
+
+func (e *jsonlExporter) Close() error {
+	return nil
+}
@@ -62,23 +62,7 @@ def _update_crontab():
   schedule: '30 5 * * *'
   emails: none
 
-# Scheduled endpoints
-- name: update-stats
-  command: curl -s https://cluebotng-review.toolforge.org/api/cron/stats
-  image: bullseye
-  filelog-stdout: logs/update_stats.stdout.log
-  filelog-stderr: logs/update_stats.stderr.log
-  schedule: '13 9 * * *'
-  emails: none
-
-- name: report-import
-  command: curl -s https://cluebotng-review.toolforge.org/api/report/import
-  image: bullseye
-  filelog-stdout: logs/report_import.stdout.log
-  filelog-stderr: logs/report_import.stderr.log
-  schedule: '13 * * * *'
-  emails: none
-
+# External scheduled endpoints
 - name: review-import
   command: curl -s https://cluebotng.toolforge.org/api/?action=review.import
   image: bullseye
@@ -86,14 +70,6 @@ def _update_crontab():
   filelog-stderr: logs/review_import.stderr.log
   schedule: '48 * * * *'
   emails: none
-
-- name: training-import
-  command: curl -s https://cluebotng-review.toolforge.org/api/training/import
-  image: bullseye
-  filelog-stdout: logs/training_import.stdout.log
-  filelog-stderr: logs/training_import.stderr.log
-  schedule: '30 * * * *'
-  emails: none
 EOL
     ''')
     c.sudo(f'XDG_CONFIG_HOME={TOOL_DIR} toolforge jobs load {TOOL_DIR / "jobs.yaml"}')
@@ -0,0 +1,194 @@
+package features
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	_ "embed"
+	"github.com/cluebotng/reviewng/db"
+	"github.com/cluebotng/reviewng/diff"
+	"strings"
+	"unicode"
+)
+
+//go:embed profanity.txt
+var profanityList string
+
+var profanity = map[string]bool{}
+
+func init() {
+	for _, word := range strings.Fields(profanityList) {
+		profanity[word] = true
+	}
+}
+
+// Numbers of the standard namespaces, keyed by the lower cased name
+var namespaces = map[string]int{
+	"":               0,
+	"main":           0,
+	"talk":           1,
+	"user":           2,
+	"user talk":      3,
+	"wikipedia":      4,
+	"wikipedia talk": 5,
+	"file":           6,
+	"file talk":      7,
+	"mediawiki":      8,
+	"mediawiki talk": 9,
+	"template":       10,
+	"template talk":  11,
+	"help":           12,
+	"help talk":      13,
+	"category":       14,
+	"category talk":  15,
+	"portal":         100,
+	"portal talk":    101,
+	"draft":          118,
+	"draft talk":     119,
+	"module":         828,
+	"module talk":    829,
+}
+
+// feature is a numeric value derived from the training data of an edit
+type feature struct {
+	Name  string
+	Value func(e *edit) float64
+}
+
+// edit holds the training data with the text added and removed by the edit, calculated once for all features
+type edit struct {
+	td      *db.TrainingData
+	added   string
+	removed string
+}
+
+// allFeatures are in the order they are exported, -1 is used for an unknown age or namespace
+var allFeatures = []feature{
+	{"account_age", func(e *edit) float64 {
+		return age(e.td.Current.User.RegistrationTime, e.td.Current.Timestamp)
+	}},
+	{"page_age", func(e *edit) float64 {
+		return age(e.td.Page.CreationTime, e.td.Current.Timestamp)
+	}},
+	{"size_delta", func(e *edit) float64 {
+		return float64(len(e.td.Current.Text) - len(e.td.Previous.Text))
+	}},
+	{"added_length", func(e *edit) float64 {
+		return float64(len([]rune(e.added)))
+	}},
+	{"removed_length", func(e *edit) float64 {
+		return float64(len([]rune(e.removed)))
+	}},
+	{"added_words", func(e *edit) float64 {
+		return float64(len(words(e.added)))
+	}},
+	{"added_caps_ratio", func(e *edit) float64 {
+		return capsRatio(e.added)
+	}},
+	{"added_profanity_hits", func(e *edit) float64 {
+		hits := 0
+		for _, word := range words(e.added) {
+			if profanity[strings.ToLower(word)] {
+				hits++
+			}
+		}
+		return float64(hits)
+	}},
+	{"added_link_count", func(e *edit) float64 {
+		return float64(strings.Count(e.added, "[[") + strings.Count(e.added, "http://") + strings.Count(e.added, "https://"))
+	}},
+	{"namespace", func(e *edit) float64 {
+		if namespace, ok := namespaces[strings.ToLower(strings.Replace(e.td.Page.Namespace, "_", " ", -1))]; ok {
+			return float64(namespace)
+		}
+		return -1
+	}},
+}
+
+func Names() []string {
+	names := []string{}
+	for _, feature := range allFeatures {
+		names = append(names, feature.Name)
+	}
+	return names
+}
+
+// Extract calculates every feature for the training data, in the order of Names
+func Extract(td *db.TrainingData) []float64 {
+	e := &edit{td: td}
+	added, removed := strings.Builder{}, strings.Builder{}
+	for _, chunk := range diff.Words(td.Previous.Text, td.Current.Text) {
+		switch chunk.Op {
+		case diff.Insert:
+			added.WriteString(chunk.Text)
+		case diff.Delete:
+			removed.WriteString(chunk.Text)
+		}
+	}
+	e.added, e.removed = added.String(), removed.String()
+
+	values := []float64{}
+	for _, feature := range allFeatures {
+		values = append(values, feature.Value(e))
+	}
+	return values
+}
+
+// ExtractMap calculates every feature for the training data, keyed by name
+func ExtractMap(td *db.TrainingData) map[string]float64 {
+	values := map[string]float64{}
+	for i, value := range Extract(td) {
+		values[allFeatures[i].Name] = value
+	}
+	return values
+}
+
+// age is the seconds from since to at, both unix timestamps
+func age(since, at int) float64 {
+	if since == 0 || at == 0 || since > at {
+		return -1
+	}
+	return float64(at - since)
+}
+
+func words(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// capsRatio is the share of letters which are upper case
+func capsRatio(text string) float64 {
+	letters, upper := 0, 0
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	if letters == 0 {
+		return 0
+	}
+	return float64(upper) / float64(letters)
+}
@@ -1,13 +1,14 @@
 module github.com/cluebotng/reviewng
 
-go 1.16
+go 1.22
 
 require (
-	github.com/dghubble/oauth1 v0.7.0 // indirect
-	github.com/go-sql-driver/mysql v1.6.0 // indirect
+	github.com/dghubble/oauth1 v0.7.0
+	github.com/go-sql-driver/mysql v1.6.0
 	github.com/gorilla/mux v1.8.0
 	github.com/gorilla/sessions v1.2.1
-	golang.org/x/lint v0.0.0-20210508222113-6edffad5e616 // indirect
-	golang.org/x/tools v0.1.5 // indirect
+	github.com/klauspost/compress v1.18.0
 	gopkg.in/yaml.v2 v2.4.0
 )
+
+require github.com/gorilla/securecookie v1.1.1 // indirect
@@ -0,0 +1,170 @@
+package jobs
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"fmt"
+	"github.com/cluebotng/reviewng/db"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	TriggerSchedule = "schedule"
+	TriggerManual   = "manual"
+)
+
+type job struct {
+	Name     string
+	Schedule *Schedule
+	Run      func() error
+}
+
+// Scheduler runs registered jobs on their schedules, with a database lock so only one replica runs each job
+type Scheduler struct {
+	dbh  *db.Db
+	jobs map[string]*job
+
+	mutex   sync.Mutex
+	running map[string]bool
+}
+
+func NewScheduler(dbh *db.Db) *Scheduler {
+	return &Scheduler{dbh: dbh, jobs: map[string]*job{}, running: map[string]bool{}}
+}
+
+// Register adds a job, an empty schedule leaves it to be triggered manually
+func (s *Scheduler) Register(name, schedule string, run func() error) error {
+	j := &job{Name: name, Run: run}
+	if schedule != "" {
+		parsed, err := ParseSchedule(schedule)
+		if err != nil {
+			return fmt.Errorf("job %s: %v", name, err)
+		}
+		j.Schedule = parsed
+	}
+	s.jobs[name] = j
+	return nil
+}
+
+func (s *Scheduler) JobNames() []string {
+	names := []string{}
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Scheduler) IsRunning(name string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.running[name]
+}
+
+// Start checks the schedules at the start of every minute
+func (s *Scheduler) Start() {
+	go func() {
+		for {
+			now := time.Now()
+			time.Sleep(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+
+			minute := time.Now().Truncate(time.Minute)
+			for _, j := range s.jobs {
+				if j.Schedule != nil && j.Schedule.Matches(minute) {
+					go s.run(j, TriggerSchedule)
+				}
+			}
+		}
+	}()
+}
+
+// Trigger runs the job in the background, returning false if it is unknown or already running here
+func (s *Scheduler) Trigger(name string) bool {
+	j, ok := s.jobs[name]
+	if !ok || s.IsRunning(name) {
+		return false
+	}
+	go s.run(j, TriggerManual)
+	return true
+}
+
+func (s *Scheduler) run(j *job, trigger string) {
+	s.mutex.Lock()
+	if s.running[j.Name] {
+		s.mutex.Unlock()
+		return
+	}
+	s.running[j.Name] = true
+	s.mutex.Unlock()
+
+	defer func() {
+		s.mutex.Lock()
+		delete(s.running, j.Name)
+		s.mutex.Unlock()
+	}()
+
+	// Another replica holding the lock is already running the job
+	lock, err := s.dbh.AcquireJobLock(j.Name)
+	if err != nil {
+		log.Printf("Failed to acquire lock for job %s: %+v", j.Name, err)
+		return
+	}
+	if lock == nil {
+		log.Printf("Job %s is running elsewhere, skipping", j.Name)
+		return
+	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			log.Printf("Failed to release lock for job %s: %+v", j.Name, err)
+		}
+	}()
+
+	runId, err := s.dbh.CreateJobRun(j.Name, trigger)
+	if err != nil {
+		log.Printf("Failed to record job %s: %+v", j.Name, err)
+		return
+	}
+
+	started := time.Now()
+	runErr := runJob(j)
+	if runErr != nil {
+		log.Printf("Job %s failed: %+v", j.Name, runErr)
+	}
+
+	if err := s.dbh.FinishJobRun(runId, time.Since(started), runErr); err != nil {
+		log.Printf("Failed to record job %s finishing: %+v", j.Name, err)
+	}
+}
+
+// runJob converts a panic in the job into an error, as handler code panics on failure
+func runJob(j *job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return j.Run()
+}
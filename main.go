@@ -24,9 +24,11 @@ package main
 
 import (
 	"embed"
+	"flag"
 	"fmt"
 	"github.com/cluebotng/reviewng/cfg"
 	"github.com/cluebotng/reviewng/controllers"
+	"net/url"
 	"os"
 )
 
@@ -49,6 +51,11 @@ func main() {
 
 	app := controllers.NewApp(config, &fsTemplates, &fsStatic)
 
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		runExport(app, os.Args[2:])
+		return
+	}
+
 	listenAddr := "0.0.0.0:8080"
 	if val, ok := os.LookupEnv("PORT"); ok {
 		listenAddr = fmt.Sprintf("0.0.0.0:%s", val)
@@ -57,3 +64,45 @@ func main() {
 	fmt.Printf("Listening on %+v\n", listenAddr)
 	app.RunForever(listenAddr)
 }
+
+func runExport(app *controllers.App, args []string) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	format := flags.String("format", "csv", "Output format (csv, jsonl, arff)")
+	done := flags.Bool("done", false, "Only export edits with a reviewed classification")
+	output := flags.String("output", "", "File to write to, defaults to stdout")
+	editGroup := flags.String("edit-group", "", "Comma separated edit group ids")
+	classification := flags.String("classification", "", "Comma separated reviewed classifications (V, C, S, U)")
+	from := flags.String("from", "", "Only edits classified after this time")
+	to := flags.String("to", "", "Only edits classified before this time")
+	since := flags.String("since", "", "Only edits with a label change after this cursor")
+	flags.Parse(args)
+
+	// Same filters as the export routes
+	query := url.Values{}
+	for name, value := range map[string]string{
+		"edit_group":     *editGroup,
+		"classification": *classification,
+		"from":           *from,
+		"to":             *to,
+		"since":          *since,
+	} {
+		if value != "" {
+			query.Set(name, value)
+		}
+	}
+
+	w := os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			panic(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := app.ExportDataset(w, *format, *done, query); err != nil {
+		fmt.Fprintf(os.Stderr, "Export failed: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -1,312 +1,312 @@
-INSERT INTO edit VALUES (397049866, 0, 1); INSERT INTO edit_edit_group VALUES (397049866, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 397049866);
-INSERT INTO edit VALUES (397703236, 0, 1); INSERT INTO edit_edit_group VALUES (397703236, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 397703236);
-INSERT INTO edit VALUES (397757198, 0, 1); INSERT INTO edit_edit_group VALUES (397757198, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 397757198);
-INSERT INTO edit VALUES (397819336, 0, 1); INSERT INTO edit_edit_group VALUES (397819336, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 397819336);
-INSERT INTO edit VALUES (397996118, 0, 1); INSERT INTO edit_edit_group VALUES (397996118, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 397996118);
-INSERT INTO edit VALUES (398064749, 0, 1); INSERT INTO edit_edit_group VALUES (398064749, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398064749);
-INSERT INTO edit VALUES (398072568, 0, 1); INSERT INTO edit_edit_group VALUES (398072568, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398072568);
-INSERT INTO edit VALUES (398118876, 0, 1); INSERT INTO edit_edit_group VALUES (398118876, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398118876);
-INSERT INTO edit VALUES (398156798, 0, 1); INSERT INTO edit_edit_group VALUES (398156798, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398156798);
-INSERT INTO edit VALUES (398164757, 0, 1); INSERT INTO edit_edit_group VALUES (398164757, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398164757);
-INSERT INTO edit VALUES (398178374, 0, 1); INSERT INTO edit_edit_group VALUES (398178374, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398178374);
-INSERT INTO edit VALUES (398199196, 0, 1); INSERT INTO edit_edit_group VALUES (398199196, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398199196);
-INSERT INTO edit VALUES (398201838, 0, 1); INSERT INTO edit_edit_group VALUES (398201838, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398201838);
-INSERT INTO edit VALUES (398206517, 0, 1); INSERT INTO edit_edit_group VALUES (398206517, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398206517);
-INSERT INTO edit VALUES (398232130, 0, 1); INSERT INTO edit_edit_group VALUES (398232130, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398232130);
-INSERT INTO edit VALUES (398240460, 0, 1); INSERT INTO edit_edit_group VALUES (398240460, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398240460);
-INSERT INTO edit VALUES (398252887, 0, 1); INSERT INTO edit_edit_group VALUES (398252887, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398252887);
-INSERT INTO edit VALUES (398285193, 0, 1); INSERT INTO edit_edit_group VALUES (398285193, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398285193);
-INSERT INTO edit VALUES (398297505, 0, 1); INSERT INTO edit_edit_group VALUES (398297505, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398297505);
-INSERT INTO edit VALUES (398336228, 0, 1); INSERT INTO edit_edit_group VALUES (398336228, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398336228);
-INSERT INTO edit VALUES (398339985, 0, 1); INSERT INTO edit_edit_group VALUES (398339985, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398339985);
-INSERT INTO edit VALUES (398351514, 0, 1); INSERT INTO edit_edit_group VALUES (398351514, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398351514);
-INSERT INTO edit VALUES (398420838, 0, 1); INSERT INTO edit_edit_group VALUES (398420838, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398420838);
-INSERT INTO edit VALUES (398430338, 0, 1); INSERT INTO edit_edit_group VALUES (398430338, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398430338);
-INSERT INTO edit VALUES (398443875, 0, 1); INSERT INTO edit_edit_group VALUES (398443875, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398443875);
-INSERT INTO edit VALUES (398507402, 0, 1); INSERT INTO edit_edit_group VALUES (398507402, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398507402);
-INSERT INTO edit VALUES (398605874, 0, 1); INSERT INTO edit_edit_group VALUES (398605874, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398605874);
-INSERT INTO edit VALUES (398621041, 0, 1); INSERT INTO edit_edit_group VALUES (398621041, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398621041);
-INSERT INTO edit VALUES (398639382, 0, 1); INSERT INTO edit_edit_group VALUES (398639382, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398639382);
-INSERT INTO edit VALUES (398645351, 0, 1); INSERT INTO edit_edit_group VALUES (398645351, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398645351);
-INSERT INTO edit VALUES (398657345, 0, 1); INSERT INTO edit_edit_group VALUES (398657345, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398657345);
-INSERT INTO edit VALUES (398673604, 0, 1); INSERT INTO edit_edit_group VALUES (398673604, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398673604);
-INSERT INTO edit VALUES (398702853, 0, 1); INSERT INTO edit_edit_group VALUES (398702853, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398702853);
-INSERT INTO edit VALUES (398743541, 0, 1); INSERT INTO edit_edit_group VALUES (398743541, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398743541);
-INSERT INTO edit VALUES (398903836, 0, 1); INSERT INTO edit_edit_group VALUES (398903836, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398903836);
-INSERT INTO edit VALUES (398904903, 0, 1); INSERT INTO edit_edit_group VALUES (398904903, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398904903);
-INSERT INTO edit VALUES (398960116, 0, 1); INSERT INTO edit_edit_group VALUES (398960116, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398960116);
-INSERT INTO edit VALUES (398962584, 0, 1); INSERT INTO edit_edit_group VALUES (398962584, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398962584);
-INSERT INTO edit VALUES (398964407, 0, 1); INSERT INTO edit_edit_group VALUES (398964407, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398964407);
-INSERT INTO edit VALUES (398967707, 0, 1); INSERT INTO edit_edit_group VALUES (398967707, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398967707);
-INSERT INTO edit VALUES (398968147, 0, 1); INSERT INTO edit_edit_group VALUES (398968147, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398968147);
-INSERT INTO edit VALUES (398969015, 0, 1); INSERT INTO edit_edit_group VALUES (398969015, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398969015);
-INSERT INTO edit VALUES (398969285, 0, 1); INSERT INTO edit_edit_group VALUES (398969285, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398969285);
-INSERT INTO edit VALUES (398970997, 0, 1); INSERT INTO edit_edit_group VALUES (398970997, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398970997);
-INSERT INTO edit VALUES (398971064, 0, 1); INSERT INTO edit_edit_group VALUES (398971064, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398971064);
-INSERT INTO edit VALUES (398971710, 0, 1); INSERT INTO edit_edit_group VALUES (398971710, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398971710);
-INSERT INTO edit VALUES (398973849, 0, 1); INSERT INTO edit_edit_group VALUES (398973849, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398973849);
-INSERT INTO edit VALUES (398976036, 0, 1); INSERT INTO edit_edit_group VALUES (398976036, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398976036);
-INSERT INTO edit VALUES (398976280, 0, 1); INSERT INTO edit_edit_group VALUES (398976280, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398976280);
-INSERT INTO edit VALUES (398977774, 0, 1); INSERT INTO edit_edit_group VALUES (398977774, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398977774);
-INSERT INTO edit VALUES (398980353, 0, 1); INSERT INTO edit_edit_group VALUES (398980353, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398980353);
-INSERT INTO edit VALUES (398998497, 0, 1); INSERT INTO edit_edit_group VALUES (398998497, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 398998497);
-INSERT INTO edit VALUES (399041003, 0, 1); INSERT INTO edit_edit_group VALUES (399041003, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399041003);
-INSERT INTO edit VALUES (399043299, 0, 1); INSERT INTO edit_edit_group VALUES (399043299, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399043299);
-INSERT INTO edit VALUES (399052602, 0, 1); INSERT INTO edit_edit_group VALUES (399052602, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399052602);
-INSERT INTO edit VALUES (399089842, 0, 1); INSERT INTO edit_edit_group VALUES (399089842, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399089842);
-INSERT INTO edit VALUES (399123710, 0, 1); INSERT INTO edit_edit_group VALUES (399123710, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399123710);
-INSERT INTO edit VALUES (399128338, 0, 1); INSERT INTO edit_edit_group VALUES (399128338, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399128338);
-INSERT INTO edit VALUES (399164147, 0, 1); INSERT INTO edit_edit_group VALUES (399164147, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399164147);
-INSERT INTO edit VALUES (399167978, 0, 1); INSERT INTO edit_edit_group VALUES (399167978, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399167978);
-INSERT INTO edit VALUES (399168950, 0, 1); INSERT INTO edit_edit_group VALUES (399168950, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399168950);
-INSERT INTO edit VALUES (399170111, 0, 1); INSERT INTO edit_edit_group VALUES (399170111, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399170111);
-INSERT INTO edit VALUES (399172721, 0, 1); INSERT INTO edit_edit_group VALUES (399172721, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399172721);
-INSERT INTO edit VALUES (399174004, 0, 1); INSERT INTO edit_edit_group VALUES (399174004, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399174004);
-INSERT INTO edit VALUES (399175243, 0, 1); INSERT INTO edit_edit_group VALUES (399175243, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399175243);
-INSERT INTO edit VALUES (399198368, 0, 1); INSERT INTO edit_edit_group VALUES (399198368, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399198368);
-INSERT INTO edit VALUES (399198872, 0, 1); INSERT INTO edit_edit_group VALUES (399198872, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399198872);
-INSERT INTO edit VALUES (399202916, 0, 1); INSERT INTO edit_edit_group VALUES (399202916, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399202916);
-INSERT INTO edit VALUES (399204056, 0, 1); INSERT INTO edit_edit_group VALUES (399204056, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399204056);
-INSERT INTO edit VALUES (399205083, 0, 1); INSERT INTO edit_edit_group VALUES (399205083, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399205083);
-INSERT INTO edit VALUES (399205597, 0, 1); INSERT INTO edit_edit_group VALUES (399205597, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399205597);
-INSERT INTO edit VALUES (399211585, 0, 1); INSERT INTO edit_edit_group VALUES (399211585, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399211585);
-INSERT INTO edit VALUES (399212119, 0, 1); INSERT INTO edit_edit_group VALUES (399212119, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399212119);
-INSERT INTO edit VALUES (399212520, 0, 1); INSERT INTO edit_edit_group VALUES (399212520, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399212520);
-INSERT INTO edit VALUES (399215158, 0, 1); INSERT INTO edit_edit_group VALUES (399215158, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399215158);
-INSERT INTO edit VALUES (399215171, 0, 1); INSERT INTO edit_edit_group VALUES (399215171, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399215171);
-INSERT INTO edit VALUES (399215754, 0, 1); INSERT INTO edit_edit_group VALUES (399215754, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399215754);
-INSERT INTO edit VALUES (399215916, 0, 1); INSERT INTO edit_edit_group VALUES (399215916, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399215916);
-INSERT INTO edit VALUES (399216868, 0, 1); INSERT INTO edit_edit_group VALUES (399216868, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399216868);
-INSERT INTO edit VALUES (399218091, 0, 1); INSERT INTO edit_edit_group VALUES (399218091, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399218091);
-INSERT INTO edit VALUES (399219171, 0, 1); INSERT INTO edit_edit_group VALUES (399219171, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399219171);
-INSERT INTO edit VALUES (399224111, 0, 1); INSERT INTO edit_edit_group VALUES (399224111, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399224111);
-INSERT INTO edit VALUES (399373831, 0, 1); INSERT INTO edit_edit_group VALUES (399373831, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399373831);
-INSERT INTO edit VALUES (399375530, 0, 1); INSERT INTO edit_edit_group VALUES (399375530, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399375530);
-INSERT INTO edit VALUES (399384822, 0, 1); INSERT INTO edit_edit_group VALUES (399384822, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399384822);
-INSERT INTO edit VALUES (399410747, 0, 1); INSERT INTO edit_edit_group VALUES (399410747, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399410747);
-INSERT INTO edit VALUES (399415208, 0, 1); INSERT INTO edit_edit_group VALUES (399415208, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399415208);
-INSERT INTO edit VALUES (399430526, 0, 1); INSERT INTO edit_edit_group VALUES (399430526, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399430526);
-INSERT INTO edit VALUES (399452611, 0, 1); INSERT INTO edit_edit_group VALUES (399452611, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399452611);
-INSERT INTO edit VALUES (399464093, 0, 1); INSERT INTO edit_edit_group VALUES (399464093, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399464093);
-INSERT INTO edit VALUES (399470103, 0, 1); INSERT INTO edit_edit_group VALUES (399470103, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399470103);
-INSERT INTO edit VALUES (399486498, 0, 1); INSERT INTO edit_edit_group VALUES (399486498, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399486498);
-INSERT INTO edit VALUES (399493673, 0, 1); INSERT INTO edit_edit_group VALUES (399493673, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399493673);
-INSERT INTO edit VALUES (399547796, 0, 1); INSERT INTO edit_edit_group VALUES (399547796, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399547796);
-INSERT INTO edit VALUES (399548330, 0, 1); INSERT INTO edit_edit_group VALUES (399548330, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399548330);
-INSERT INTO edit VALUES (399599201, 0, 1); INSERT INTO edit_edit_group VALUES (399599201, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399599201);
-INSERT INTO edit VALUES (399608886, 0, 1); INSERT INTO edit_edit_group VALUES (399608886, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399608886);
-INSERT INTO edit VALUES (399690658, 0, 1); INSERT INTO edit_edit_group VALUES (399690658, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399690658);
-INSERT INTO edit VALUES (399702245, 0, 1); INSERT INTO edit_edit_group VALUES (399702245, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399702245);
-INSERT INTO edit VALUES (399745847, 0, 1); INSERT INTO edit_edit_group VALUES (399745847, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399745847);
-INSERT INTO edit VALUES (399751084, 0, 1); INSERT INTO edit_edit_group VALUES (399751084, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399751084);
-INSERT INTO edit VALUES (399766601, 0, 1); INSERT INTO edit_edit_group VALUES (399766601, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399766601);
-INSERT INTO edit VALUES (399789205, 0, 1); INSERT INTO edit_edit_group VALUES (399789205, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399789205);
-INSERT INTO edit VALUES (399792701, 0, 1); INSERT INTO edit_edit_group VALUES (399792701, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399792701);
-INSERT INTO edit VALUES (399799377, 0, 1); INSERT INTO edit_edit_group VALUES (399799377, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399799377);
-INSERT INTO edit VALUES (399848903, 0, 1); INSERT INTO edit_edit_group VALUES (399848903, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399848903);
-INSERT INTO edit VALUES (399912165, 0, 1); INSERT INTO edit_edit_group VALUES (399912165, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399912165);
-INSERT INTO edit VALUES (399963438, 0, 1); INSERT INTO edit_edit_group VALUES (399963438, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 399963438);
-INSERT INTO edit VALUES (400314086, 0, 1); INSERT INTO edit_edit_group VALUES (400314086, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400314086);
-INSERT INTO edit VALUES (400323915, 0, 1); INSERT INTO edit_edit_group VALUES (400323915, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400323915);
-INSERT INTO edit VALUES (400343393, 0, 1); INSERT INTO edit_edit_group VALUES (400343393, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400343393);
-INSERT INTO edit VALUES (400365048, 0, 1); INSERT INTO edit_edit_group VALUES (400365048, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400365048);
-INSERT INTO edit VALUES (400458374, 0, 1); INSERT INTO edit_edit_group VALUES (400458374, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400458374);
-INSERT INTO edit VALUES (400510776, 0, 1); INSERT INTO edit_edit_group VALUES (400510776, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400510776);
-INSERT INTO edit VALUES (400558330, 0, 1); INSERT INTO edit_edit_group VALUES (400558330, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400558330);
-INSERT INTO edit VALUES (400573270, 0, 1); INSERT INTO edit_edit_group VALUES (400573270, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400573270);
-INSERT INTO edit VALUES (400584169, 0, 1); INSERT INTO edit_edit_group VALUES (400584169, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400584169);
-INSERT INTO edit VALUES (400588680, 0, 1); INSERT INTO edit_edit_group VALUES (400588680, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400588680);
-INSERT INTO edit VALUES (400594529, 0, 1); INSERT INTO edit_edit_group VALUES (400594529, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400594529);
-INSERT INTO edit VALUES (400639851, 0, 1); INSERT INTO edit_edit_group VALUES (400639851, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400639851);
-INSERT INTO edit VALUES (400844670, 0, 1); INSERT INTO edit_edit_group VALUES (400844670, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400844670);
-INSERT INTO edit VALUES (400860600, 0, 1); INSERT INTO edit_edit_group VALUES (400860600, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400860600);
-INSERT INTO edit VALUES (400932077, 0, 1); INSERT INTO edit_edit_group VALUES (400932077, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400932077);
-INSERT INTO edit VALUES (400940677, 0, 1); INSERT INTO edit_edit_group VALUES (400940677, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 400940677);
-INSERT INTO edit VALUES (401005940, 0, 1); INSERT INTO edit_edit_group VALUES (401005940, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401005940);
-INSERT INTO edit VALUES (401064959, 0, 1); INSERT INTO edit_edit_group VALUES (401064959, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401064959);
-INSERT INTO edit VALUES (401181724, 0, 1); INSERT INTO edit_edit_group VALUES (401181724, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401181724);
-INSERT INTO edit VALUES (401187251, 0, 1); INSERT INTO edit_edit_group VALUES (401187251, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401187251);
-INSERT INTO edit VALUES (401231642, 0, 1); INSERT INTO edit_edit_group VALUES (401231642, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401231642);
-INSERT INTO edit VALUES (401252933, 0, 1); INSERT INTO edit_edit_group VALUES (401252933, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401252933);
-INSERT INTO edit VALUES (401364039, 0, 1); INSERT INTO edit_edit_group VALUES (401364039, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401364039);
-INSERT INTO edit VALUES (401384394, 0, 1); INSERT INTO edit_edit_group VALUES (401384394, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401384394);
-INSERT INTO edit VALUES (401387804, 0, 1); INSERT INTO edit_edit_group VALUES (401387804, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401387804);
-INSERT INTO edit VALUES (401388521, 0, 1); INSERT INTO edit_edit_group VALUES (401388521, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401388521);
-INSERT INTO edit VALUES (401411175, 0, 1); INSERT INTO edit_edit_group VALUES (401411175, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401411175);
-INSERT INTO edit VALUES (401438237, 0, 1); INSERT INTO edit_edit_group VALUES (401438237, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401438237);
-INSERT INTO edit VALUES (401481316, 0, 1); INSERT INTO edit_edit_group VALUES (401481316, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401481316);
-INSERT INTO edit VALUES (401483904, 0, 1); INSERT INTO edit_edit_group VALUES (401483904, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401483904);
-INSERT INTO edit VALUES (401489746, 0, 1); INSERT INTO edit_edit_group VALUES (401489746, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401489746);
-INSERT INTO edit VALUES (401493440, 0, 1); INSERT INTO edit_edit_group VALUES (401493440, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401493440);
-INSERT INTO edit VALUES (401561513, 0, 1); INSERT INTO edit_edit_group VALUES (401561513, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401561513);
-INSERT INTO edit VALUES (401584664, 0, 1); INSERT INTO edit_edit_group VALUES (401584664, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401584664);
-INSERT INTO edit VALUES (401593672, 0, 1); INSERT INTO edit_edit_group VALUES (401593672, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401593672);
-INSERT INTO edit VALUES (401595948, 0, 1); INSERT INTO edit_edit_group VALUES (401595948, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401595948);
-INSERT INTO edit VALUES (401638479, 0, 1); INSERT INTO edit_edit_group VALUES (401638479, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401638479);
-INSERT INTO edit VALUES (401659543, 0, 1); INSERT INTO edit_edit_group VALUES (401659543, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401659543);
-INSERT INTO edit VALUES (401668280, 0, 1); INSERT INTO edit_edit_group VALUES (401668280, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401668280);
-INSERT INTO edit VALUES (401669000, 0, 1); INSERT INTO edit_edit_group VALUES (401669000, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401669000);
-INSERT INTO edit VALUES (401696612, 0, 1); INSERT INTO edit_edit_group VALUES (401696612, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401696612);
-INSERT INTO edit VALUES (401699384, 0, 1); INSERT INTO edit_edit_group VALUES (401699384, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401699384);
-INSERT INTO edit VALUES (401706749, 0, 1); INSERT INTO edit_edit_group VALUES (401706749, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401706749);
-INSERT INTO edit VALUES (401714843, 0, 1); INSERT INTO edit_edit_group VALUES (401714843, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401714843);
-INSERT INTO edit VALUES (401718113, 0, 1); INSERT INTO edit_edit_group VALUES (401718113, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401718113);
-INSERT INTO edit VALUES (401732921, 0, 1); INSERT INTO edit_edit_group VALUES (401732921, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401732921);
-INSERT INTO edit VALUES (401747846, 0, 1); INSERT INTO edit_edit_group VALUES (401747846, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401747846);
-INSERT INTO edit VALUES (401757774, 0, 1); INSERT INTO edit_edit_group VALUES (401757774, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401757774);
-INSERT INTO edit VALUES (401768117, 0, 1); INSERT INTO edit_edit_group VALUES (401768117, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401768117);
-INSERT INTO edit VALUES (401810554, 0, 1); INSERT INTO edit_edit_group VALUES (401810554, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401810554);
-INSERT INTO edit VALUES (401846563, 0, 1); INSERT INTO edit_edit_group VALUES (401846563, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401846563);
-INSERT INTO edit VALUES (401851545, 0, 1); INSERT INTO edit_edit_group VALUES (401851545, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401851545);
-INSERT INTO edit VALUES (401875653, 0, 1); INSERT INTO edit_edit_group VALUES (401875653, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401875653);
-INSERT INTO edit VALUES (401878468, 0, 1); INSERT INTO edit_edit_group VALUES (401878468, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401878468);
-INSERT INTO edit VALUES (401879668, 0, 1); INSERT INTO edit_edit_group VALUES (401879668, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401879668);
-INSERT INTO edit VALUES (401880702, 0, 1); INSERT INTO edit_edit_group VALUES (401880702, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401880702);
-INSERT INTO edit VALUES (401896528, 0, 1); INSERT INTO edit_edit_group VALUES (401896528, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401896528);
-INSERT INTO edit VALUES (401910059, 0, 1); INSERT INTO edit_edit_group VALUES (401910059, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401910059);
-INSERT INTO edit VALUES (401915725, 0, 1); INSERT INTO edit_edit_group VALUES (401915725, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401915725);
-INSERT INTO edit VALUES (401918980, 0, 1); INSERT INTO edit_edit_group VALUES (401918980, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401918980);
-INSERT INTO edit VALUES (401959435, 0, 1); INSERT INTO edit_edit_group VALUES (401959435, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401959435);
-INSERT INTO edit VALUES (401965646, 0, 1); INSERT INTO edit_edit_group VALUES (401965646, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401965646);
-INSERT INTO edit VALUES (401969720, 0, 1); INSERT INTO edit_edit_group VALUES (401969720, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401969720);
-INSERT INTO edit VALUES (401987874, 0, 1); INSERT INTO edit_edit_group VALUES (401987874, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401987874);
-INSERT INTO edit VALUES (401989610, 0, 1); INSERT INTO edit_edit_group VALUES (401989610, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401989610);
-INSERT INTO edit VALUES (401994538, 0, 1); INSERT INTO edit_edit_group VALUES (401994538, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 401994538);
-INSERT INTO edit VALUES (402040837, 0, 1); INSERT INTO edit_edit_group VALUES (402040837, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402040837);
-INSERT INTO edit VALUES (402086611, 0, 1); INSERT INTO edit_edit_group VALUES (402086611, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402086611);
-INSERT INTO edit VALUES (402208041, 0, 1); INSERT INTO edit_edit_group VALUES (402208041, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402208041);
-INSERT INTO edit VALUES (402212663, 0, 1); INSERT INTO edit_edit_group VALUES (402212663, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402212663);
-INSERT INTO edit VALUES (402233448, 0, 1); INSERT INTO edit_edit_group VALUES (402233448, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402233448);
-INSERT INTO edit VALUES (402270697, 0, 1); INSERT INTO edit_edit_group VALUES (402270697, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402270697);
-INSERT INTO edit VALUES (402275547, 0, 1); INSERT INTO edit_edit_group VALUES (402275547, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402275547);
-INSERT INTO edit VALUES (402276378, 0, 1); INSERT INTO edit_edit_group VALUES (402276378, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402276378);
-INSERT INTO edit VALUES (402298121, 0, 1); INSERT INTO edit_edit_group VALUES (402298121, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402298121);
-INSERT INTO edit VALUES (402357788, 0, 1); INSERT INTO edit_edit_group VALUES (402357788, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402357788);
-INSERT INTO edit VALUES (402359374, 0, 1); INSERT INTO edit_edit_group VALUES (402359374, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402359374);
-INSERT INTO edit VALUES (402362324, 0, 1); INSERT INTO edit_edit_group VALUES (402362324, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402362324);
-INSERT INTO edit VALUES (402392618, 0, 1); INSERT INTO edit_edit_group VALUES (402392618, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402392618);
-INSERT INTO edit VALUES (402421301, 0, 1); INSERT INTO edit_edit_group VALUES (402421301, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402421301);
-INSERT INTO edit VALUES (402468127, 0, 1); INSERT INTO edit_edit_group VALUES (402468127, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402468127);
-INSERT INTO edit VALUES (402513904, 0, 1); INSERT INTO edit_edit_group VALUES (402513904, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402513904);
-INSERT INTO edit VALUES (402537000, 0, 1); INSERT INTO edit_edit_group VALUES (402537000, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402537000);
-INSERT INTO edit VALUES (402623986, 0, 1); INSERT INTO edit_edit_group VALUES (402623986, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402623986);
-INSERT INTO edit VALUES (402626676, 0, 1); INSERT INTO edit_edit_group VALUES (402626676, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402626676);
-INSERT INTO edit VALUES (402635571, 0, 1); INSERT INTO edit_edit_group VALUES (402635571, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402635571);
-INSERT INTO edit VALUES (402651219, 0, 1); INSERT INTO edit_edit_group VALUES (402651219, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402651219);
-INSERT INTO edit VALUES (402655271, 0, 1); INSERT INTO edit_edit_group VALUES (402655271, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402655271);
-INSERT INTO edit VALUES (402674988, 0, 1); INSERT INTO edit_edit_group VALUES (402674988, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402674988);
-INSERT INTO edit VALUES (402675264, 0, 1); INSERT INTO edit_edit_group VALUES (402675264, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402675264);
-INSERT INTO edit VALUES (402750752, 0, 1); INSERT INTO edit_edit_group VALUES (402750752, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402750752);
-INSERT INTO edit VALUES (402769814, 0, 1); INSERT INTO edit_edit_group VALUES (402769814, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402769814);
-INSERT INTO edit VALUES (402800592, 0, 1); INSERT INTO edit_edit_group VALUES (402800592, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402800592);
-INSERT INTO edit VALUES (402829481, 0, 1); INSERT INTO edit_edit_group VALUES (402829481, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402829481);
-INSERT INTO edit VALUES (402852713, 0, 1); INSERT INTO edit_edit_group VALUES (402852713, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402852713);
-INSERT INTO edit VALUES (402897915, 0, 1); INSERT INTO edit_edit_group VALUES (402897915, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402897915);
-INSERT INTO edit VALUES (402935755, 0, 1); INSERT INTO edit_edit_group VALUES (402935755, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402935755);
-INSERT INTO edit VALUES (402953410, 0, 1); INSERT INTO edit_edit_group VALUES (402953410, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402953410);
-INSERT INTO edit VALUES (402981097, 0, 1); INSERT INTO edit_edit_group VALUES (402981097, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402981097);
-INSERT INTO edit VALUES (402990711, 0, 1); INSERT INTO edit_edit_group VALUES (402990711, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402990711);
-INSERT INTO edit VALUES (402996176, 0, 1); INSERT INTO edit_edit_group VALUES (402996176, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 402996176);
-INSERT INTO edit VALUES (403023951, 0, 1); INSERT INTO edit_edit_group VALUES (403023951, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403023951);
-INSERT INTO edit VALUES (403044777, 0, 1); INSERT INTO edit_edit_group VALUES (403044777, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403044777);
-INSERT INTO edit VALUES (403060309, 0, 1); INSERT INTO edit_edit_group VALUES (403060309, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403060309);
-INSERT INTO edit VALUES (403062181, 0, 1); INSERT INTO edit_edit_group VALUES (403062181, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403062181);
-INSERT INTO edit VALUES (403088838, 0, 1); INSERT INTO edit_edit_group VALUES (403088838, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403088838);
-INSERT INTO edit VALUES (403113918, 0, 1); INSERT INTO edit_edit_group VALUES (403113918, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403113918);
-INSERT INTO edit VALUES (403118791, 0, 1); INSERT INTO edit_edit_group VALUES (403118791, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403118791);
-INSERT INTO edit VALUES (403123421, 0, 1); INSERT INTO edit_edit_group VALUES (403123421, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403123421);
-INSERT INTO edit VALUES (403213426, 0, 1); INSERT INTO edit_edit_group VALUES (403213426, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403213426);
-INSERT INTO edit VALUES (403228016, 0, 1); INSERT INTO edit_edit_group VALUES (403228016, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403228016);
-INSERT INTO edit VALUES (403239971, 0, 1); INSERT INTO edit_edit_group VALUES (403239971, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403239971);
-INSERT INTO edit VALUES (403283321, 0, 1); INSERT INTO edit_edit_group VALUES (403283321, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403283321);
-INSERT INTO edit VALUES (403326190, 0, 1); INSERT INTO edit_edit_group VALUES (403326190, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403326190);
-INSERT INTO edit VALUES (403351077, 0, 1); INSERT INTO edit_edit_group VALUES (403351077, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403351077);
-INSERT INTO edit VALUES (403462822, 0, 1); INSERT INTO edit_edit_group VALUES (403462822, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403462822);
-INSERT INTO edit VALUES (403490030, 0, 1); INSERT INTO edit_edit_group VALUES (403490030, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403490030);
-INSERT INTO edit VALUES (403778890, 0, 1); INSERT INTO edit_edit_group VALUES (403778890, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403778890);
-INSERT INTO edit VALUES (403803386, 0, 1); INSERT INTO edit_edit_group VALUES (403803386, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403803386);
-INSERT INTO edit VALUES (403812921, 0, 1); INSERT INTO edit_edit_group VALUES (403812921, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403812921);
-INSERT INTO edit VALUES (403893781, 0, 1); INSERT INTO edit_edit_group VALUES (403893781, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403893781);
-INSERT INTO edit VALUES (403896826, 0, 1); INSERT INTO edit_edit_group VALUES (403896826, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403896826);
-INSERT INTO edit VALUES (403911336, 0, 1); INSERT INTO edit_edit_group VALUES (403911336, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403911336);
-INSERT INTO edit VALUES (403932307, 0, 1); INSERT INTO edit_edit_group VALUES (403932307, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403932307);
-INSERT INTO edit VALUES (403932565, 0, 1); INSERT INTO edit_edit_group VALUES (403932565, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403932565);
-INSERT INTO edit VALUES (403969425, 0, 1); INSERT INTO edit_edit_group VALUES (403969425, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403969425);
-INSERT INTO edit VALUES (403989192, 0, 1); INSERT INTO edit_edit_group VALUES (403989192, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 403989192);
-INSERT INTO edit VALUES (404019767, 0, 1); INSERT INTO edit_edit_group VALUES (404019767, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404019767);
-INSERT INTO edit VALUES (404037669, 0, 1); INSERT INTO edit_edit_group VALUES (404037669, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404037669);
-INSERT INTO edit VALUES (404082299, 0, 1); INSERT INTO edit_edit_group VALUES (404082299, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404082299);
-INSERT INTO edit VALUES (404101092, 0, 1); INSERT INTO edit_edit_group VALUES (404101092, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404101092);
-INSERT INTO edit VALUES (404162517, 0, 1); INSERT INTO edit_edit_group VALUES (404162517, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404162517);
-INSERT INTO edit VALUES (404181795, 0, 1); INSERT INTO edit_edit_group VALUES (404181795, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404181795);
-INSERT INTO edit VALUES (404190906, 0, 1); INSERT INTO edit_edit_group VALUES (404190906, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404190906);
-INSERT INTO edit VALUES (404194455, 0, 1); INSERT INTO edit_edit_group VALUES (404194455, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404194455);
-INSERT INTO edit VALUES (404240586, 0, 1); INSERT INTO edit_edit_group VALUES (404240586, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404240586);
-INSERT INTO edit VALUES (404257453, 0, 1); INSERT INTO edit_edit_group VALUES (404257453, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404257453);
-INSERT INTO edit VALUES (404267597, 0, 1); INSERT INTO edit_edit_group VALUES (404267597, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404267597);
-INSERT INTO edit VALUES (404405574, 0, 1); INSERT INTO edit_edit_group VALUES (404405574, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404405574);
-INSERT INTO edit VALUES (404454137, 0, 1); INSERT INTO edit_edit_group VALUES (404454137, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404454137);
-INSERT INTO edit VALUES (404460799, 0, 1); INSERT INTO edit_edit_group VALUES (404460799, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404460799);
-INSERT INTO edit VALUES (404462290, 0, 1); INSERT INTO edit_edit_group VALUES (404462290, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404462290);
-INSERT INTO edit VALUES (404514953, 0, 1); INSERT INTO edit_edit_group VALUES (404514953, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404514953);
-INSERT INTO edit VALUES (404524226, 0, 1); INSERT INTO edit_edit_group VALUES (404524226, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404524226);
-INSERT INTO edit VALUES (404555692, 0, 1); INSERT INTO edit_edit_group VALUES (404555692, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404555692);
-INSERT INTO edit VALUES (404556819, 0, 1); INSERT INTO edit_edit_group VALUES (404556819, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404556819);
-INSERT INTO edit VALUES (404605594, 0, 1); INSERT INTO edit_edit_group VALUES (404605594, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404605594);
-INSERT INTO edit VALUES (404649257, 0, 1); INSERT INTO edit_edit_group VALUES (404649257, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404649257);
-INSERT INTO edit VALUES (404657983, 0, 1); INSERT INTO edit_edit_group VALUES (404657983, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404657983);
-INSERT INTO edit VALUES (404667550, 0, 1); INSERT INTO edit_edit_group VALUES (404667550, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404667550);
-INSERT INTO edit VALUES (404680475, 0, 1); INSERT INTO edit_edit_group VALUES (404680475, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404680475);
-INSERT INTO edit VALUES (404686767, 0, 1); INSERT INTO edit_edit_group VALUES (404686767, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404686767);
-INSERT INTO edit VALUES (404720044, 0, 1); INSERT INTO edit_edit_group VALUES (404720044, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404720044);
-INSERT INTO edit VALUES (404723388, 0, 1); INSERT INTO edit_edit_group VALUES (404723388, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404723388);
-INSERT INTO edit VALUES (404769788, 0, 1); INSERT INTO edit_edit_group VALUES (404769788, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404769788);
-INSERT INTO edit VALUES (404786283, 0, 1); INSERT INTO edit_edit_group VALUES (404786283, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404786283);
-INSERT INTO edit VALUES (404803205, 0, 1); INSERT INTO edit_edit_group VALUES (404803205, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404803205);
-INSERT INTO edit VALUES (404838868, 0, 1); INSERT INTO edit_edit_group VALUES (404838868, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404838868);
-INSERT INTO edit VALUES (404932757, 0, 1); INSERT INTO edit_edit_group VALUES (404932757, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 404932757);
-INSERT INTO edit VALUES (405039315, 0, 1); INSERT INTO edit_edit_group VALUES (405039315, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405039315);
-INSERT INTO edit VALUES (405055165, 0, 1); INSERT INTO edit_edit_group VALUES (405055165, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405055165);
-INSERT INTO edit VALUES (405089914, 0, 1); INSERT INTO edit_edit_group VALUES (405089914, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405089914);
-INSERT INTO edit VALUES (405138346, 0, 1); INSERT INTO edit_edit_group VALUES (405138346, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405138346);
-INSERT INTO edit VALUES (405148925, 0, 1); INSERT INTO edit_edit_group VALUES (405148925, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405148925);
-INSERT INTO edit VALUES (405150786, 0, 1); INSERT INTO edit_edit_group VALUES (405150786, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405150786);
-INSERT INTO edit VALUES (405186729, 0, 1); INSERT INTO edit_edit_group VALUES (405186729, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405186729);
-INSERT INTO edit VALUES (405260398, 0, 1); INSERT INTO edit_edit_group VALUES (405260398, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405260398);
-INSERT INTO edit VALUES (405260451, 0, 1); INSERT INTO edit_edit_group VALUES (405260451, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405260451);
-INSERT INTO edit VALUES (405263046, 0, 1); INSERT INTO edit_edit_group VALUES (405263046, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405263046);
-INSERT INTO edit VALUES (405270815, 0, 1); INSERT INTO edit_edit_group VALUES (405270815, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405270815);
-INSERT INTO edit VALUES (405310451, 0, 1); INSERT INTO edit_edit_group VALUES (405310451, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405310451);
-INSERT INTO edit VALUES (405386670, 0, 1); INSERT INTO edit_edit_group VALUES (405386670, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405386670);
-INSERT INTO edit VALUES (405386760, 0, 1); INSERT INTO edit_edit_group VALUES (405386760, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405386760);
-INSERT INTO edit VALUES (405410620, 0, 1); INSERT INTO edit_edit_group VALUES (405410620, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 1, 405410620);
-INSERT INTO edit VALUES (398239130, 0, 0); INSERT INTO edit_edit_group VALUES (398239130, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 398239130);
-INSERT INTO edit VALUES (398582362, 0, 0); INSERT INTO edit_edit_group VALUES (398582362, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 398582362);
-INSERT INTO edit VALUES (398747335, 0, 0); INSERT INTO edit_edit_group VALUES (398747335, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 398747335);
-INSERT INTO edit VALUES (398927028, 0, 0); INSERT INTO edit_edit_group VALUES (398927028, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 398927028);
-INSERT INTO edit VALUES (399079511, 0, 0); INSERT INTO edit_edit_group VALUES (399079511, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 399079511);
-INSERT INTO edit VALUES (399215397, 0, 0); INSERT INTO edit_edit_group VALUES (399215397, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 399215397);
-INSERT INTO edit VALUES (399707916, 0, 0); INSERT INTO edit_edit_group VALUES (399707916, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 399707916);
-INSERT INTO edit VALUES (400364576, 0, 0); INSERT INTO edit_edit_group VALUES (400364576, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 400364576);
-INSERT INTO edit VALUES (400402696, 0, 0); INSERT INTO edit_edit_group VALUES (400402696, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 400402696);
-INSERT INTO edit VALUES (400729494, 0, 0); INSERT INTO edit_edit_group VALUES (400729494, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 400729494);
-INSERT INTO edit VALUES (401271581, 0, 0); INSERT INTO edit_edit_group VALUES (401271581, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 401271581);
-INSERT INTO edit VALUES (401578973, 0, 0); INSERT INTO edit_edit_group VALUES (401578973, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 401578973);
-INSERT INTO edit VALUES (401793570, 0, 0); INSERT INTO edit_edit_group VALUES (401793570, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 401793570);
-INSERT INTO edit VALUES (401819228, 0, 0); INSERT INTO edit_edit_group VALUES (401819228, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 401819228);
-INSERT INTO edit VALUES (402251729, 0, 0); INSERT INTO edit_edit_group VALUES (402251729, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 402251729);
-INSERT INTO edit VALUES (402340902, 0, 0); INSERT INTO edit_edit_group VALUES (402340902, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 402340902);
-INSERT INTO edit VALUES (402541873, 0, 0); INSERT INTO edit_edit_group VALUES (402541873, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 402541873);
-INSERT INTO edit VALUES (402553389, 0, 0); INSERT INTO edit_edit_group VALUES (402553389, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 402553389);
-INSERT INTO edit VALUES (402856229, 0, 0); INSERT INTO edit_edit_group VALUES (402856229, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 402856229);
-INSERT INTO edit VALUES (403029756, 0, 0); INSERT INTO edit_edit_group VALUES (403029756, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 403029756);
-INSERT INTO edit VALUES (403236936, 0, 0); INSERT INTO edit_edit_group VALUES (403236936, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 403236936);
-INSERT INTO edit VALUES (403237286, 0, 0); INSERT INTO edit_edit_group VALUES (403237286, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 403237286);
-INSERT INTO edit VALUES (403332314, 0, 0); INSERT INTO edit_edit_group VALUES (403332314, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 403332314);
-INSERT INTO edit VALUES (403400776, 0, 0); INSERT INTO edit_edit_group VALUES (403400776, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 403400776);
-INSERT INTO edit VALUES (403401612, 0, 0); INSERT INTO edit_edit_group VALUES (403401612, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 403401612);
-INSERT INTO edit VALUES (403447277, 0, 0); INSERT INTO edit_edit_group VALUES (403447277, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 403447277);
-INSERT INTO edit VALUES (403831387, 0, 0); INSERT INTO edit_edit_group VALUES (403831387, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 403831387);
-INSERT INTO edit VALUES (404153670, 0, 0); INSERT INTO edit_edit_group VALUES (404153670, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 404153670);
-INSERT INTO edit VALUES (404268434, 0, 0); INSERT INTO edit_edit_group VALUES (404268434, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 404268434);
-INSERT INTO edit VALUES (405392147, 0, 0); INSERT INTO edit_edit_group VALUES (405392147, 1); INSERT INTO user_classification VALUES (null, -1, "Import from report data", 0, 405392147);
+INSERT INTO edit VALUES (397049866, 0, 1); INSERT INTO edit_edit_group VALUES (397049866, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 397049866);
+INSERT INTO edit VALUES (397703236, 0, 1); INSERT INTO edit_edit_group VALUES (397703236, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 397703236);
+INSERT INTO edit VALUES (397757198, 0, 1); INSERT INTO edit_edit_group VALUES (397757198, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 397757198);
+INSERT INTO edit VALUES (397819336, 0, 1); INSERT INTO edit_edit_group VALUES (397819336, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 397819336);
+INSERT INTO edit VALUES (397996118, 0, 1); INSERT INTO edit_edit_group VALUES (397996118, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 397996118);
+INSERT INTO edit VALUES (398064749, 0, 1); INSERT INTO edit_edit_group VALUES (398064749, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398064749);
+INSERT INTO edit VALUES (398072568, 0, 1); INSERT INTO edit_edit_group VALUES (398072568, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398072568);
+INSERT INTO edit VALUES (398118876, 0, 1); INSERT INTO edit_edit_group VALUES (398118876, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398118876);
+INSERT INTO edit VALUES (398156798, 0, 1); INSERT INTO edit_edit_group VALUES (398156798, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398156798);
+INSERT INTO edit VALUES (398164757, 0, 1); INSERT INTO edit_edit_group VALUES (398164757, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398164757);
+INSERT INTO edit VALUES (398178374, 0, 1); INSERT INTO edit_edit_group VALUES (398178374, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398178374);
+INSERT INTO edit VALUES (398199196, 0, 1); INSERT INTO edit_edit_group VALUES (398199196, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398199196);
+INSERT INTO edit VALUES (398201838, 0, 1); INSERT INTO edit_edit_group VALUES (398201838, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398201838);
+INSERT INTO edit VALUES (398206517, 0, 1); INSERT INTO edit_edit_group VALUES (398206517, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398206517);
+INSERT INTO edit VALUES (398232130, 0, 1); INSERT INTO edit_edit_group VALUES (398232130, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398232130);
+INSERT INTO edit VALUES (398240460, 0, 1); INSERT INTO edit_edit_group VALUES (398240460, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398240460);
+INSERT INTO edit VALUES (398252887, 0, 1); INSERT INTO edit_edit_group VALUES (398252887, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398252887);
+INSERT INTO edit VALUES (398285193, 0, 1); INSERT INTO edit_edit_group VALUES (398285193, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398285193);
+INSERT INTO edit VALUES (398297505, 0, 1); INSERT INTO edit_edit_group VALUES (398297505, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398297505);
+INSERT INTO edit VALUES (398336228, 0, 1); INSERT INTO edit_edit_group VALUES (398336228, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398336228);
+INSERT INTO edit VALUES (398339985, 0, 1); INSERT INTO edit_edit_group VALUES (398339985, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398339985);
+INSERT INTO edit VALUES (398351514, 0, 1); INSERT INTO edit_edit_group VALUES (398351514, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398351514);
+INSERT INTO edit VALUES (398420838, 0, 1); INSERT INTO edit_edit_group VALUES (398420838, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398420838);
+INSERT INTO edit VALUES (398430338, 0, 1); INSERT INTO edit_edit_group VALUES (398430338, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398430338);
+INSERT INTO edit VALUES (398443875, 0, 1); INSERT INTO edit_edit_group VALUES (398443875, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398443875);
+INSERT INTO edit VALUES (398507402, 0, 1); INSERT INTO edit_edit_group VALUES (398507402, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398507402);
+INSERT INTO edit VALUES (398605874, 0, 1); INSERT INTO edit_edit_group VALUES (398605874, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398605874);
+INSERT INTO edit VALUES (398621041, 0, 1); INSERT INTO edit_edit_group VALUES (398621041, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398621041);
+INSERT INTO edit VALUES (398639382, 0, 1); INSERT INTO edit_edit_group VALUES (398639382, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398639382);
+INSERT INTO edit VALUES (398645351, 0, 1); INSERT INTO edit_edit_group VALUES (398645351, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398645351);
+INSERT INTO edit VALUES (398657345, 0, 1); INSERT INTO edit_edit_group VALUES (398657345, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398657345);
+INSERT INTO edit VALUES (398673604, 0, 1); INSERT INTO edit_edit_group VALUES (398673604, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398673604);
+INSERT INTO edit VALUES (398702853, 0, 1); INSERT INTO edit_edit_group VALUES (398702853, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398702853);
+INSERT INTO edit VALUES (398743541, 0, 1); INSERT INTO edit_edit_group VALUES (398743541, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398743541);
+INSERT INTO edit VALUES (398903836, 0, 1); INSERT INTO edit_edit_group VALUES (398903836, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398903836);
+INSERT INTO edit VALUES (398904903, 0, 1); INSERT INTO edit_edit_group VALUES (398904903, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398904903);
+INSERT INTO edit VALUES (398960116, 0, 1); INSERT INTO edit_edit_group VALUES (398960116, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398960116);
+INSERT INTO edit VALUES (398962584, 0, 1); INSERT INTO edit_edit_group VALUES (398962584, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398962584);
+INSERT INTO edit VALUES (398964407, 0, 1); INSERT INTO edit_edit_group VALUES (398964407, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398964407);
+INSERT INTO edit VALUES (398967707, 0, 1); INSERT INTO edit_edit_group VALUES (398967707, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398967707);
+INSERT INTO edit VALUES (398968147, 0, 1); INSERT INTO edit_edit_group VALUES (398968147, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398968147);
+INSERT INTO edit VALUES (398969015, 0, 1); INSERT INTO edit_edit_group VALUES (398969015, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398969015);
+INSERT INTO edit VALUES (398969285, 0, 1); INSERT INTO edit_edit_group VALUES (398969285, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398969285);
+INSERT INTO edit VALUES (398970997, 0, 1); INSERT INTO edit_edit_group VALUES (398970997, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398970997);
+INSERT INTO edit VALUES (398971064, 0, 1); INSERT INTO edit_edit_group VALUES (398971064, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398971064);
+INSERT INTO edit VALUES (398971710, 0, 1); INSERT INTO edit_edit_group VALUES (398971710, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398971710);
+INSERT INTO edit VALUES (398973849, 0, 1); INSERT INTO edit_edit_group VALUES (398973849, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398973849);
+INSERT INTO edit VALUES (398976036, 0, 1); INSERT INTO edit_edit_group VALUES (398976036, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398976036);
+INSERT INTO edit VALUES (398976280, 0, 1); INSERT INTO edit_edit_group VALUES (398976280, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398976280);
+INSERT INTO edit VALUES (398977774, 0, 1); INSERT INTO edit_edit_group VALUES (398977774, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398977774);
+INSERT INTO edit VALUES (398980353, 0, 1); INSERT INTO edit_edit_group VALUES (398980353, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398980353);
+INSERT INTO edit VALUES (398998497, 0, 1); INSERT INTO edit_edit_group VALUES (398998497, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 398998497);
+INSERT INTO edit VALUES (399041003, 0, 1); INSERT INTO edit_edit_group VALUES (399041003, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399041003);
+INSERT INTO edit VALUES (399043299, 0, 1); INSERT INTO edit_edit_group VALUES (399043299, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399043299);
+INSERT INTO edit VALUES (399052602, 0, 1); INSERT INTO edit_edit_group VALUES (399052602, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399052602);
+INSERT INTO edit VALUES (399089842, 0, 1); INSERT INTO edit_edit_group VALUES (399089842, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399089842);
+INSERT INTO edit VALUES (399123710, 0, 1); INSERT INTO edit_edit_group VALUES (399123710, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399123710);
+INSERT INTO edit VALUES (399128338, 0, 1); INSERT INTO edit_edit_group VALUES (399128338, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399128338);
+INSERT INTO edit VALUES (399164147, 0, 1); INSERT INTO edit_edit_group VALUES (399164147, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399164147);
+INSERT INTO edit VALUES (399167978, 0, 1); INSERT INTO edit_edit_group VALUES (399167978, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399167978);
+INSERT INTO edit VALUES (399168950, 0, 1); INSERT INTO edit_edit_group VALUES (399168950, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399168950);
+INSERT INTO edit VALUES (399170111, 0, 1); INSERT INTO edit_edit_group VALUES (399170111, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399170111);
+INSERT INTO edit VALUES (399172721, 0, 1); INSERT INTO edit_edit_group VALUES (399172721, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399172721);
+INSERT INTO edit VALUES (399174004, 0, 1); INSERT INTO edit_edit_group VALUES (399174004, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399174004);
+INSERT INTO edit VALUES (399175243, 0, 1); INSERT INTO edit_edit_group VALUES (399175243, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399175243);
+INSERT INTO edit VALUES (399198368, 0, 1); INSERT INTO edit_edit_group VALUES (399198368, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399198368);
+INSERT INTO edit VALUES (399198872, 0, 1); INSERT INTO edit_edit_group VALUES (399198872, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399198872);
+INSERT INTO edit VALUES (399202916, 0, 1); INSERT INTO edit_edit_group VALUES (399202916, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399202916);
+INSERT INTO edit VALUES (399204056, 0, 1); INSERT INTO edit_edit_group VALUES (399204056, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399204056);
+INSERT INTO edit VALUES (399205083, 0, 1); INSERT INTO edit_edit_group VALUES (399205083, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399205083);
+INSERT INTO edit VALUES (399205597, 0, 1); INSERT INTO edit_edit_group VALUES (399205597, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399205597);
+INSERT INTO edit VALUES (399211585, 0, 1); INSERT INTO edit_edit_group VALUES (399211585, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399211585);
+INSERT INTO edit VALUES (399212119, 0, 1); INSERT INTO edit_edit_group VALUES (399212119, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399212119);
+INSERT INTO edit VALUES (399212520, 0, 1); INSERT INTO edit_edit_group VALUES (399212520, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399212520);
+INSERT INTO edit VALUES (399215158, 0, 1); INSERT INTO edit_edit_group VALUES (399215158, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399215158);
+INSERT INTO edit VALUES (399215171, 0, 1); INSERT INTO edit_edit_group VALUES (399215171, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399215171);
+INSERT INTO edit VALUES (399215754, 0, 1); INSERT INTO edit_edit_group VALUES (399215754, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399215754);
+INSERT INTO edit VALUES (399215916, 0, 1); INSERT INTO edit_edit_group VALUES (399215916, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399215916);
+INSERT INTO edit VALUES (399216868, 0, 1); INSERT INTO edit_edit_group VALUES (399216868, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399216868);
+INSERT INTO edit VALUES (399218091, 0, 1); INSERT INTO edit_edit_group VALUES (399218091, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399218091);
+INSERT INTO edit VALUES (399219171, 0, 1); INSERT INTO edit_edit_group VALUES (399219171, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399219171);
+INSERT INTO edit VALUES (399224111, 0, 1); INSERT INTO edit_edit_group VALUES (399224111, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399224111);
+INSERT INTO edit VALUES (399373831, 0, 1); INSERT INTO edit_edit_group VALUES (399373831, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399373831);
+INSERT INTO edit VALUES (399375530, 0, 1); INSERT INTO edit_edit_group VALUES (399375530, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399375530);
+INSERT INTO edit VALUES (399384822, 0, 1); INSERT INTO edit_edit_group VALUES (399384822, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399384822);
+INSERT INTO edit VALUES (399410747, 0, 1); INSERT INTO edit_edit_group VALUES (399410747, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399410747);
+INSERT INTO edit VALUES (399415208, 0, 1); INSERT INTO edit_edit_group VALUES (399415208, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399415208);
+INSERT INTO edit VALUES (399430526, 0, 1); INSERT INTO edit_edit_group VALUES (399430526, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399430526);
+INSERT INTO edit VALUES (399452611, 0, 1); INSERT INTO edit_edit_group VALUES (399452611, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399452611);
+INSERT INTO edit VALUES (399464093, 0, 1); INSERT INTO edit_edit_group VALUES (399464093, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399464093);
+INSERT INTO edit VALUES (399470103, 0, 1); INSERT INTO edit_edit_group VALUES (399470103, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399470103);
+INSERT INTO edit VALUES (399486498, 0, 1); INSERT INTO edit_edit_group VALUES (399486498, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399486498);
+INSERT INTO edit VALUES (399493673, 0, 1); INSERT INTO edit_edit_group VALUES (399493673, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399493673);
+INSERT INTO edit VALUES (399547796, 0, 1); INSERT INTO edit_edit_group VALUES (399547796, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399547796);
+INSERT INTO edit VALUES (399548330, 0, 1); INSERT INTO edit_edit_group VALUES (399548330, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399548330);
+INSERT INTO edit VALUES (399599201, 0, 1); INSERT INTO edit_edit_group VALUES (399599201, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399599201);
+INSERT INTO edit VALUES (399608886, 0, 1); INSERT INTO edit_edit_group VALUES (399608886, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399608886);
+INSERT INTO edit VALUES (399690658, 0, 1); INSERT INTO edit_edit_group VALUES (399690658, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399690658);
+INSERT INTO edit VALUES (399702245, 0, 1); INSERT INTO edit_edit_group VALUES (399702245, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399702245);
+INSERT INTO edit VALUES (399745847, 0, 1); INSERT INTO edit_edit_group VALUES (399745847, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399745847);
+INSERT INTO edit VALUES (399751084, 0, 1); INSERT INTO edit_edit_group VALUES (399751084, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399751084);
+INSERT INTO edit VALUES (399766601, 0, 1); INSERT INTO edit_edit_group VALUES (399766601, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399766601);
+INSERT INTO edit VALUES (399789205, 0, 1); INSERT INTO edit_edit_group VALUES (399789205, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399789205);
+INSERT INTO edit VALUES (399792701, 0, 1); INSERT INTO edit_edit_group VALUES (399792701, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399792701);
+INSERT INTO edit VALUES (399799377, 0, 1); INSERT INTO edit_edit_group VALUES (399799377, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399799377);
+INSERT INTO edit VALUES (399848903, 0, 1); INSERT INTO edit_edit_group VALUES (399848903, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399848903);
+INSERT INTO edit VALUES (399912165, 0, 1); INSERT INTO edit_edit_group VALUES (399912165, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399912165);
+INSERT INTO edit VALUES (399963438, 0, 1); INSERT INTO edit_edit_group VALUES (399963438, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 399963438);
+INSERT INTO edit VALUES (400314086, 0, 1); INSERT INTO edit_edit_group VALUES (400314086, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400314086);
+INSERT INTO edit VALUES (400323915, 0, 1); INSERT INTO edit_edit_group VALUES (400323915, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400323915);
+INSERT INTO edit VALUES (400343393, 0, 1); INSERT INTO edit_edit_group VALUES (400343393, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400343393);
+INSERT INTO edit VALUES (400365048, 0, 1); INSERT INTO edit_edit_group VALUES (400365048, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400365048);
+INSERT INTO edit VALUES (400458374, 0, 1); INSERT INTO edit_edit_group VALUES (400458374, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400458374);
+INSERT INTO edit VALUES (400510776, 0, 1); INSERT INTO edit_edit_group VALUES (400510776, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400510776);
+INSERT INTO edit VALUES (400558330, 0, 1); INSERT INTO edit_edit_group VALUES (400558330, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400558330);
+INSERT INTO edit VALUES (400573270, 0, 1); INSERT INTO edit_edit_group VALUES (400573270, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400573270);
+INSERT INTO edit VALUES (400584169, 0, 1); INSERT INTO edit_edit_group VALUES (400584169, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400584169);
+INSERT INTO edit VALUES (400588680, 0, 1); INSERT INTO edit_edit_group VALUES (400588680, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400588680);
+INSERT INTO edit VALUES (400594529, 0, 1); INSERT INTO edit_edit_group VALUES (400594529, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400594529);
+INSERT INTO edit VALUES (400639851, 0, 1); INSERT INTO edit_edit_group VALUES (400639851, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400639851);
+INSERT INTO edit VALUES (400844670, 0, 1); INSERT INTO edit_edit_group VALUES (400844670, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400844670);
+INSERT INTO edit VALUES (400860600, 0, 1); INSERT INTO edit_edit_group VALUES (400860600, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400860600);
+INSERT INTO edit VALUES (400932077, 0, 1); INSERT INTO edit_edit_group VALUES (400932077, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400932077);
+INSERT INTO edit VALUES (400940677, 0, 1); INSERT INTO edit_edit_group VALUES (400940677, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 400940677);
+INSERT INTO edit VALUES (401005940, 0, 1); INSERT INTO edit_edit_group VALUES (401005940, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401005940);
+INSERT INTO edit VALUES (401064959, 0, 1); INSERT INTO edit_edit_group VALUES (401064959, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401064959);
+INSERT INTO edit VALUES (401181724, 0, 1); INSERT INTO edit_edit_group VALUES (401181724, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401181724);
+INSERT INTO edit VALUES (401187251, 0, 1); INSERT INTO edit_edit_group VALUES (401187251, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401187251);
+INSERT INTO edit VALUES (401231642, 0, 1); INSERT INTO edit_edit_group VALUES (401231642, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401231642);
+INSERT INTO edit VALUES (401252933, 0, 1); INSERT INTO edit_edit_group VALUES (401252933, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401252933);
+INSERT INTO edit VALUES (401364039, 0, 1); INSERT INTO edit_edit_group VALUES (401364039, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401364039);
+INSERT INTO edit VALUES (401384394, 0, 1); INSERT INTO edit_edit_group VALUES (401384394, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401384394);
+INSERT INTO edit VALUES (401387804, 0, 1); INSERT INTO edit_edit_group VALUES (401387804, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401387804);
+INSERT INTO edit VALUES (401388521, 0, 1); INSERT INTO edit_edit_group VALUES (401388521, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401388521);
+INSERT INTO edit VALUES (401411175, 0, 1); INSERT INTO edit_edit_group VALUES (401411175, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401411175);
+INSERT INTO edit VALUES (401438237, 0, 1); INSERT INTO edit_edit_group VALUES (401438237, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401438237);
+INSERT INTO edit VALUES (401481316, 0, 1); INSERT INTO edit_edit_group VALUES (401481316, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401481316);
+INSERT INTO edit VALUES (401483904, 0, 1); INSERT INTO edit_edit_group VALUES (401483904, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401483904);
+INSERT INTO edit VALUES (401489746, 0, 1); INSERT INTO edit_edit_group VALUES (401489746, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401489746);
+INSERT INTO edit VALUES (401493440, 0, 1); INSERT INTO edit_edit_group VALUES (401493440, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401493440);
+INSERT INTO edit VALUES (401561513, 0, 1); INSERT INTO edit_edit_group VALUES (401561513, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401561513);
+INSERT INTO edit VALUES (401584664, 0, 1); INSERT INTO edit_edit_group VALUES (401584664, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401584664);
+INSERT INTO edit VALUES (401593672, 0, 1); INSERT INTO edit_edit_group VALUES (401593672, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401593672);
+INSERT INTO edit VALUES (401595948, 0, 1); INSERT INTO edit_edit_group VALUES (401595948, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401595948);
+INSERT INTO edit VALUES (401638479, 0, 1); INSERT INTO edit_edit_group VALUES (401638479, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401638479);
+INSERT INTO edit VALUES (401659543, 0, 1); INSERT INTO edit_edit_group VALUES (401659543, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401659543);
+INSERT INTO edit VALUES (401668280, 0, 1); INSERT INTO edit_edit_group VALUES (401668280, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401668280);
+INSERT INTO edit VALUES (401669000, 0, 1); INSERT INTO edit_edit_group VALUES (401669000, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401669000);
+INSERT INTO edit VALUES (401696612, 0, 1); INSERT INTO edit_edit_group VALUES (401696612, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401696612);
+INSERT INTO edit VALUES (401699384, 0, 1); INSERT INTO edit_edit_group VALUES (401699384, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401699384);
+INSERT INTO edit VALUES (401706749, 0, 1); INSERT INTO edit_edit_group VALUES (401706749, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401706749);
+INSERT INTO edit VALUES (401714843, 0, 1); INSERT INTO edit_edit_group VALUES (401714843, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401714843);
+INSERT INTO edit VALUES (401718113, 0, 1); INSERT INTO edit_edit_group VALUES (401718113, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401718113);
+INSERT INTO edit VALUES (401732921, 0, 1); INSERT INTO edit_edit_group VALUES (401732921, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401732921);
+INSERT INTO edit VALUES (401747846, 0, 1); INSERT INTO edit_edit_group VALUES (401747846, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401747846);
+INSERT INTO edit VALUES (401757774, 0, 1); INSERT INTO edit_edit_group VALUES (401757774, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401757774);
+INSERT INTO edit VALUES (401768117, 0, 1); INSERT INTO edit_edit_group VALUES (401768117, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401768117);
+INSERT INTO edit VALUES (401810554, 0, 1); INSERT INTO edit_edit_group VALUES (401810554, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401810554);
+INSERT INTO edit VALUES (401846563, 0, 1); INSERT INTO edit_edit_group VALUES (401846563, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401846563);
+INSERT INTO edit VALUES (401851545, 0, 1); INSERT INTO edit_edit_group VALUES (401851545, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401851545);
+INSERT INTO edit VALUES (401875653, 0, 1); INSERT INTO edit_edit_group VALUES (401875653, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401875653);
+INSERT INTO edit VALUES (401878468, 0, 1); INSERT INTO edit_edit_group VALUES (401878468, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401878468);
+INSERT INTO edit VALUES (401879668, 0, 1); INSERT INTO edit_edit_group VALUES (401879668, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401879668);
+INSERT INTO edit VALUES (401880702, 0, 1); INSERT INTO edit_edit_group VALUES (401880702, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401880702);
+INSERT INTO edit VALUES (401896528, 0, 1); INSERT INTO edit_edit_group VALUES (401896528, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401896528);
+INSERT INTO edit VALUES (401910059, 0, 1); INSERT INTO edit_edit_group VALUES (401910059, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401910059);
+INSERT INTO edit VALUES (401915725, 0, 1); INSERT INTO edit_edit_group VALUES (401915725, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401915725);
+INSERT INTO edit VALUES (401918980, 0, 1); INSERT INTO edit_edit_group VALUES (401918980, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401918980);
+INSERT INTO edit VALUES (401959435, 0, 1); INSERT INTO edit_edit_group VALUES (401959435, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401959435);
+INSERT INTO edit VALUES (401965646, 0, 1); INSERT INTO edit_edit_group VALUES (401965646, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401965646);
+INSERT INTO edit VALUES (401969720, 0, 1); INSERT INTO edit_edit_group VALUES (401969720, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401969720);
+INSERT INTO edit VALUES (401987874, 0, 1); INSERT INTO edit_edit_group VALUES (401987874, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401987874);
+INSERT INTO edit VALUES (401989610, 0, 1); INSERT INTO edit_edit_group VALUES (401989610, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401989610);
+INSERT INTO edit VALUES (401994538, 0, 1); INSERT INTO edit_edit_group VALUES (401994538, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 401994538);
+INSERT INTO edit VALUES (402040837, 0, 1); INSERT INTO edit_edit_group VALUES (402040837, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402040837);
+INSERT INTO edit VALUES (402086611, 0, 1); INSERT INTO edit_edit_group VALUES (402086611, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402086611);
+INSERT INTO edit VALUES (402208041, 0, 1); INSERT INTO edit_edit_group VALUES (402208041, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402208041);
+INSERT INTO edit VALUES (402212663, 0, 1); INSERT INTO edit_edit_group VALUES (402212663, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402212663);
+INSERT INTO edit VALUES (402233448, 0, 1); INSERT INTO edit_edit_group VALUES (402233448, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402233448);
+INSERT INTO edit VALUES (402270697, 0, 1); INSERT INTO edit_edit_group VALUES (402270697, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402270697);
+INSERT INTO edit VALUES (402275547, 0, 1); INSERT INTO edit_edit_group VALUES (402275547, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402275547);
+INSERT INTO edit VALUES (402276378, 0, 1); INSERT INTO edit_edit_group VALUES (402276378, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402276378);
+INSERT INTO edit VALUES (402298121, 0, 1); INSERT INTO edit_edit_group VALUES (402298121, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402298121);
+INSERT INTO edit VALUES (402357788, 0, 1); INSERT INTO edit_edit_group VALUES (402357788, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402357788);
+INSERT INTO edit VALUES (402359374, 0, 1); INSERT INTO edit_edit_group VALUES (402359374, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402359374);
+INSERT INTO edit VALUES (402362324, 0, 1); INSERT INTO edit_edit_group VALUES (402362324, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402362324);
+INSERT INTO edit VALUES (402392618, 0, 1); INSERT INTO edit_edit_group VALUES (402392618, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402392618);
+INSERT INTO edit VALUES (402421301, 0, 1); INSERT INTO edit_edit_group VALUES (402421301, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402421301);
+INSERT INTO edit VALUES (402468127, 0, 1); INSERT INTO edit_edit_group VALUES (402468127, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402468127);
+INSERT INTO edit VALUES (402513904, 0, 1); INSERT INTO edit_edit_group VALUES (402513904, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402513904);
+INSERT INTO edit VALUES (402537000, 0, 1); INSERT INTO edit_edit_group VALUES (402537000, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402537000);
+INSERT INTO edit VALUES (402623986, 0, 1); INSERT INTO edit_edit_group VALUES (402623986, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402623986);
+INSERT INTO edit VALUES (402626676, 0, 1); INSERT INTO edit_edit_group VALUES (402626676, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402626676);
+INSERT INTO edit VALUES (402635571, 0, 1); INSERT INTO edit_edit_group VALUES (402635571, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402635571);
+INSERT INTO edit VALUES (402651219, 0, 1); INSERT INTO edit_edit_group VALUES (402651219, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402651219);
+INSERT INTO edit VALUES (402655271, 0, 1); INSERT INTO edit_edit_group VALUES (402655271, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402655271);
+INSERT INTO edit VALUES (402674988, 0, 1); INSERT INTO edit_edit_group VALUES (402674988, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402674988);
+INSERT INTO edit VALUES (402675264, 0, 1); INSERT INTO edit_edit_group VALUES (402675264, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402675264);
+INSERT INTO edit VALUES (402750752, 0, 1); INSERT INTO edit_edit_group VALUES (402750752, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402750752);
+INSERT INTO edit VALUES (402769814, 0, 1); INSERT INTO edit_edit_group VALUES (402769814, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402769814);
+INSERT INTO edit VALUES (402800592, 0, 1); INSERT INTO edit_edit_group VALUES (402800592, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402800592);
+INSERT INTO edit VALUES (402829481, 0, 1); INSERT INTO edit_edit_group VALUES (402829481, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402829481);
+INSERT INTO edit VALUES (402852713, 0, 1); INSERT INTO edit_edit_group VALUES (402852713, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402852713);
+INSERT INTO edit VALUES (402897915, 0, 1); INSERT INTO edit_edit_group VALUES (402897915, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402897915);
+INSERT INTO edit VALUES (402935755, 0, 1); INSERT INTO edit_edit_group VALUES (402935755, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402935755);
+INSERT INTO edit VALUES (402953410, 0, 1); INSERT INTO edit_edit_group VALUES (402953410, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402953410);
+INSERT INTO edit VALUES (402981097, 0, 1); INSERT INTO edit_edit_group VALUES (402981097, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402981097);
+INSERT INTO edit VALUES (402990711, 0, 1); INSERT INTO edit_edit_group VALUES (402990711, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402990711);
+INSERT INTO edit VALUES (402996176, 0, 1); INSERT INTO edit_edit_group VALUES (402996176, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 402996176);
+INSERT INTO edit VALUES (403023951, 0, 1); INSERT INTO edit_edit_group VALUES (403023951, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403023951);
+INSERT INTO edit VALUES (403044777, 0, 1); INSERT INTO edit_edit_group VALUES (403044777, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403044777);
+INSERT INTO edit VALUES (403060309, 0, 1); INSERT INTO edit_edit_group VALUES (403060309, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403060309);
+INSERT INTO edit VALUES (403062181, 0, 1); INSERT INTO edit_edit_group VALUES (403062181, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403062181);
+INSERT INTO edit VALUES (403088838, 0, 1); INSERT INTO edit_edit_group VALUES (403088838, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403088838);
+INSERT INTO edit VALUES (403113918, 0, 1); INSERT INTO edit_edit_group VALUES (403113918, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403113918);
+INSERT INTO edit VALUES (403118791, 0, 1); INSERT INTO edit_edit_group VALUES (403118791, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403118791);
+INSERT INTO edit VALUES (403123421, 0, 1); INSERT INTO edit_edit_group VALUES (403123421, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403123421);
+INSERT INTO edit VALUES (403213426, 0, 1); INSERT INTO edit_edit_group VALUES (403213426, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403213426);
+INSERT INTO edit VALUES (403228016, 0, 1); INSERT INTO edit_edit_group VALUES (403228016, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403228016);
+INSERT INTO edit VALUES (403239971, 0, 1); INSERT INTO edit_edit_group VALUES (403239971, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403239971);
+INSERT INTO edit VALUES (403283321, 0, 1); INSERT INTO edit_edit_group VALUES (403283321, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403283321);
+INSERT INTO edit VALUES (403326190, 0, 1); INSERT INTO edit_edit_group VALUES (403326190, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403326190);
+INSERT INTO edit VALUES (403351077, 0, 1); INSERT INTO edit_edit_group VALUES (403351077, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403351077);
+INSERT INTO edit VALUES (403462822, 0, 1); INSERT INTO edit_edit_group VALUES (403462822, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403462822);
+INSERT INTO edit VALUES (403490030, 0, 1); INSERT INTO edit_edit_group VALUES (403490030, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403490030);
+INSERT INTO edit VALUES (403778890, 0, 1); INSERT INTO edit_edit_group VALUES (403778890, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403778890);
+INSERT INTO edit VALUES (403803386, 0, 1); INSERT INTO edit_edit_group VALUES (403803386, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403803386);
+INSERT INTO edit VALUES (403812921, 0, 1); INSERT INTO edit_edit_group VALUES (403812921, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403812921);
+INSERT INTO edit VALUES (403893781, 0, 1); INSERT INTO edit_edit_group VALUES (403893781, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403893781);
+INSERT INTO edit VALUES (403896826, 0, 1); INSERT INTO edit_edit_group VALUES (403896826, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403896826);
+INSERT INTO edit VALUES (403911336, 0, 1); INSERT INTO edit_edit_group VALUES (403911336, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403911336);
+INSERT INTO edit VALUES (403932307, 0, 1); INSERT INTO edit_edit_group VALUES (403932307, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403932307);
+INSERT INTO edit VALUES (403932565, 0, 1); INSERT INTO edit_edit_group VALUES (403932565, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403932565);
+INSERT INTO edit VALUES (403969425, 0, 1); INSERT INTO edit_edit_group VALUES (403969425, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403969425);
+INSERT INTO edit VALUES (403989192, 0, 1); INSERT INTO edit_edit_group VALUES (403989192, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 403989192);
+INSERT INTO edit VALUES (404019767, 0, 1); INSERT INTO edit_edit_group VALUES (404019767, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404019767);
+INSERT INTO edit VALUES (404037669, 0, 1); INSERT INTO edit_edit_group VALUES (404037669, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404037669);
+INSERT INTO edit VALUES (404082299, 0, 1); INSERT INTO edit_edit_group VALUES (404082299, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404082299);
+INSERT INTO edit VALUES (404101092, 0, 1); INSERT INTO edit_edit_group VALUES (404101092, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404101092);
+INSERT INTO edit VALUES (404162517, 0, 1); INSERT INTO edit_edit_group VALUES (404162517, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404162517);
+INSERT INTO edit VALUES (404181795, 0, 1); INSERT INTO edit_edit_group VALUES (404181795, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404181795);
+INSERT INTO edit VALUES (404190906, 0, 1); INSERT INTO edit_edit_group VALUES (404190906, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404190906);
+INSERT INTO edit VALUES (404194455, 0, 1); INSERT INTO edit_edit_group VALUES (404194455, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404194455);
+INSERT INTO edit VALUES (404240586, 0, 1); INSERT INTO edit_edit_group VALUES (404240586, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404240586);
+INSERT INTO edit VALUES (404257453, 0, 1); INSERT INTO edit_edit_group VALUES (404257453, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404257453);
+INSERT INTO edit VALUES (404267597, 0, 1); INSERT INTO edit_edit_group VALUES (404267597, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404267597);
+INSERT INTO edit VALUES (404405574, 0, 1); INSERT INTO edit_edit_group VALUES (404405574, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404405574);
+INSERT INTO edit VALUES (404454137, 0, 1); INSERT INTO edit_edit_group VALUES (404454137, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404454137);
+INSERT INTO edit VALUES (404460799, 0, 1); INSERT INTO edit_edit_group VALUES (404460799, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404460799);
+INSERT INTO edit VALUES (404462290, 0, 1); INSERT INTO edit_edit_group VALUES (404462290, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404462290);
+INSERT INTO edit VALUES (404514953, 0, 1); INSERT INTO edit_edit_group VALUES (404514953, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404514953);
+INSERT INTO edit VALUES (404524226, 0, 1); INSERT INTO edit_edit_group VALUES (404524226, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404524226);
+INSERT INTO edit VALUES (404555692, 0, 1); INSERT INTO edit_edit_group VALUES (404555692, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404555692);
+INSERT INTO edit VALUES (404556819, 0, 1); INSERT INTO edit_edit_group VALUES (404556819, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404556819);
+INSERT INTO edit VALUES (404605594, 0, 1); INSERT INTO edit_edit_group VALUES (404605594, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404605594);
+INSERT INTO edit VALUES (404649257, 0, 1); INSERT INTO edit_edit_group VALUES (404649257, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404649257);
+INSERT INTO edit VALUES (404657983, 0, 1); INSERT INTO edit_edit_group VALUES (404657983, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404657983);
+INSERT INTO edit VALUES (404667550, 0, 1); INSERT INTO edit_edit_group VALUES (404667550, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404667550);
+INSERT INTO edit VALUES (404680475, 0, 1); INSERT INTO edit_edit_group VALUES (404680475, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404680475);
+INSERT INTO edit VALUES (404686767, 0, 1); INSERT INTO edit_edit_group VALUES (404686767, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404686767);
+INSERT INTO edit VALUES (404720044, 0, 1); INSERT INTO edit_edit_group VALUES (404720044, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404720044);
+INSERT INTO edit VALUES (404723388, 0, 1); INSERT INTO edit_edit_group VALUES (404723388, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404723388);
+INSERT INTO edit VALUES (404769788, 0, 1); INSERT INTO edit_edit_group VALUES (404769788, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404769788);
+INSERT INTO edit VALUES (404786283, 0, 1); INSERT INTO edit_edit_group VALUES (404786283, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404786283);
+INSERT INTO edit VALUES (404803205, 0, 1); INSERT INTO edit_edit_group VALUES (404803205, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404803205);
+INSERT INTO edit VALUES (404838868, 0, 1); INSERT INTO edit_edit_group VALUES (404838868, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404838868);
+INSERT INTO edit VALUES (404932757, 0, 1); INSERT INTO edit_edit_group VALUES (404932757, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 404932757);
+INSERT INTO edit VALUES (405039315, 0, 1); INSERT INTO edit_edit_group VALUES (405039315, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405039315);
+INSERT INTO edit VALUES (405055165, 0, 1); INSERT INTO edit_edit_group VALUES (405055165, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405055165);
+INSERT INTO edit VALUES (405089914, 0, 1); INSERT INTO edit_edit_group VALUES (405089914, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405089914);
+INSERT INTO edit VALUES (405138346, 0, 1); INSERT INTO edit_edit_group VALUES (405138346, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405138346);
+INSERT INTO edit VALUES (405148925, 0, 1); INSERT INTO edit_edit_group VALUES (405148925, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405148925);
+INSERT INTO edit VALUES (405150786, 0, 1); INSERT INTO edit_edit_group VALUES (405150786, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405150786);
+INSERT INTO edit VALUES (405186729, 0, 1); INSERT INTO edit_edit_group VALUES (405186729, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405186729);
+INSERT INTO edit VALUES (405260398, 0, 1); INSERT INTO edit_edit_group VALUES (405260398, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405260398);
+INSERT INTO edit VALUES (405260451, 0, 1); INSERT INTO edit_edit_group VALUES (405260451, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405260451);
+INSERT INTO edit VALUES (405263046, 0, 1); INSERT INTO edit_edit_group VALUES (405263046, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405263046);
+INSERT INTO edit VALUES (405270815, 0, 1); INSERT INTO edit_edit_group VALUES (405270815, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405270815);
+INSERT INTO edit VALUES (405310451, 0, 1); INSERT INTO edit_edit_group VALUES (405310451, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405310451);
+INSERT INTO edit VALUES (405386670, 0, 1); INSERT INTO edit_edit_group VALUES (405386670, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405386670);
+INSERT INTO edit VALUES (405386760, 0, 1); INSERT INTO edit_edit_group VALUES (405386760, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405386760);
+INSERT INTO edit VALUES (405410620, 0, 1); INSERT INTO edit_edit_group VALUES (405410620, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 1, 405410620);
+INSERT INTO edit VALUES (398239130, 0, 0); INSERT INTO edit_edit_group VALUES (398239130, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 398239130);
+INSERT INTO edit VALUES (398582362, 0, 0); INSERT INTO edit_edit_group VALUES (398582362, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 398582362);
+INSERT INTO edit VALUES (398747335, 0, 0); INSERT INTO edit_edit_group VALUES (398747335, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 398747335);
+INSERT INTO edit VALUES (398927028, 0, 0); INSERT INTO edit_edit_group VALUES (398927028, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 398927028);
+INSERT INTO edit VALUES (399079511, 0, 0); INSERT INTO edit_edit_group VALUES (399079511, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 399079511);
+INSERT INTO edit VALUES (399215397, 0, 0); INSERT INTO edit_edit_group VALUES (399215397, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 399215397);
+INSERT INTO edit VALUES (399707916, 0, 0); INSERT INTO edit_edit_group VALUES (399707916, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 399707916);
+INSERT INTO edit VALUES (400364576, 0, 0); INSERT INTO edit_edit_group VALUES (400364576, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 400364576);
+INSERT INTO edit VALUES (400402696, 0, 0); INSERT INTO edit_edit_group VALUES (400402696, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 400402696);
+INSERT INTO edit VALUES (400729494, 0, 0); INSERT INTO edit_edit_group VALUES (400729494, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 400729494);
+INSERT INTO edit VALUES (401271581, 0, 0); INSERT INTO edit_edit_group VALUES (401271581, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 401271581);
+INSERT INTO edit VALUES (401578973, 0, 0); INSERT INTO edit_edit_group VALUES (401578973, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 401578973);
+INSERT INTO edit VALUES (401793570, 0, 0); INSERT INTO edit_edit_group VALUES (401793570, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 401793570);
+INSERT INTO edit VALUES (401819228, 0, 0); INSERT INTO edit_edit_group VALUES (401819228, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 401819228);
+INSERT INTO edit VALUES (402251729, 0, 0); INSERT INTO edit_edit_group VALUES (402251729, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 402251729);
+INSERT INTO edit VALUES (402340902, 0, 0); INSERT INTO edit_edit_group VALUES (402340902, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 402340902);
+INSERT INTO edit VALUES (402541873, 0, 0); INSERT INTO edit_edit_group VALUES (402541873, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 402541873);
+INSERT INTO edit VALUES (402553389, 0, 0); INSERT INTO edit_edit_group VALUES (402553389, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 402553389);
+INSERT INTO edit VALUES (402856229, 0, 0); INSERT INTO edit_edit_group VALUES (402856229, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 402856229);
+INSERT INTO edit VALUES (403029756, 0, 0); INSERT INTO edit_edit_group VALUES (403029756, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 403029756);
+INSERT INTO edit VALUES (403236936, 0, 0); INSERT INTO edit_edit_group VALUES (403236936, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 403236936);
+INSERT INTO edit VALUES (403237286, 0, 0); INSERT INTO edit_edit_group VALUES (403237286, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 403237286);
+INSERT INTO edit VALUES (403332314, 0, 0); INSERT INTO edit_edit_group VALUES (403332314, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 403332314);
+INSERT INTO edit VALUES (403400776, 0, 0); INSERT INTO edit_edit_group VALUES (403400776, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 403400776);
+INSERT INTO edit VALUES (403401612, 0, 0); INSERT INTO edit_edit_group VALUES (403401612, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 403401612);
+INSERT INTO edit VALUES (403447277, 0, 0); INSERT INTO edit_edit_group VALUES (403447277, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 403447277);
+INSERT INTO edit VALUES (403831387, 0, 0); INSERT INTO edit_edit_group VALUES (403831387, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 403831387);
+INSERT INTO edit VALUES (404153670, 0, 0); INSERT INTO edit_edit_group VALUES (404153670, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 404153670);
+INSERT INTO edit VALUES (404268434, 0, 0); INSERT INTO edit_edit_group VALUES (404268434, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 404268434);
+INSERT INTO edit VALUES (405392147, 0, 0); INSERT INTO edit_edit_group VALUES (405392147, 1); INSERT INTO user_classification (id, user_id, comment, classification, edit_id) VALUES (null, -1, "Import from report data", 0, 405392147);
@@ -1,4 +1,4 @@
-INSERT INTO `edit_group` VALUES
+INSERT INTO `edit_group` (id, name, weight) VALUES
     (1, "Legacy Report Interface Import", 0),
     (2, "Report Interface Import", 45),
     (3, "r81 False Negatives", 20),
@@ -6,6 +6,7 @@ CREATE TABLE `users`
     `approved`     tinyint(1) NOT NULL DEFAULT 0,
     `admin`        tinyint(1) NOT NULL DEFAULT 0,
     `legacy_count` int          NOT NULL DEFAULT 0,
+    `suspended`    tinyint(1) NOT NULL DEFAULT 0,
     PRIMARY KEY (`id`),
     UNIQUE KEY `username` (`username`)
 ) ENGINE = InnoDB
@@ -20,10 +21,14 @@ CREATE TABLE `user_classification`
     `comment`        varchar(1024) NULL,
     `classification` int NOT NULL,
     `edit_id`        int NOT NULL,
+    `quarantined`    tinyint(1) NOT NULL DEFAULT 0,
+    `created`        datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
     PRIMARY KEY (`id`),
     INDEX            `user_id` (`user_id`),
     INDEX            `edit_id` (`edit_id`),
     INDEX            `classification` (`classification`),
+    INDEX            `quarantined` (`quarantined`),
+    INDEX            `created` (`created`),
     UNIQUE KEY `user_edit` (`user_id`, `edit_id`)
 ) ENGINE = InnoDB
   DEFAULT CHARSET = utf8mb4
@@ -35,7 +40,9 @@ CREATE TABLE `edit_group`
     `id`     int          NOT NULL AUTO_INCREMENT,
     `name`   varchar(255) NOT NULL,
     `weight` int          NOT NULL,
-    PRIMARY KEY (`id`)
+    `wiki`   varchar(64)  NOT NULL DEFAULT 'enwiki',
+    PRIMARY KEY (`id`),
+    INDEX    `wiki` (`wiki`)
 ) ENGINE = InnoDB
   DEFAULT CHARSET = utf8mb4
   COLLATE = utf8mb4_bin;
@@ -67,8 +74,268 @@ DROP TABLE IF EXISTS `edit_training_data`;
 CREATE TABLE `edit_training_data`
 (
     `edit_id`             int NOT NULL,
+    `encoding`            int NOT NULL DEFAULT 0,
+    `training_data`       longblob NOT NULL,
+    `current_text_hash`   char(64) NOT NULL DEFAULT '',
+    `previous_text_hash`  char(64) NOT NULL DEFAULT '',
+    `fetched`             datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `source`              varchar(255) NOT NULL DEFAULT '',
+    `schema_version`      int NOT NULL DEFAULT 0,
+    `content_hash`        char(64) NOT NULL DEFAULT '',
+    `validation_status`   int NOT NULL DEFAULT 0,
+    `validation_reason`   varchar(1024) NOT NULL DEFAULT '',
+    PRIMARY KEY (`edit_id`),
+    INDEX                 `fetched` (`fetched`),
+    INDEX                 `validation_status` (`validation_status`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `edit_training_data_history`;
+CREATE TABLE `edit_training_data_history`
+(
+    `id`                  int NOT NULL AUTO_INCREMENT,
+    `edit_id`             int NOT NULL,
+    `encoding`            int NOT NULL,
     `training_data`       longblob NOT NULL,
+    `current_text_hash`   char(64) NOT NULL,
+    `previous_text_hash`  char(64) NOT NULL,
+    `fetched`             datetime NOT NULL,
+    `source`              varchar(255) NOT NULL,
+    `schema_version`      int NOT NULL,
+    `content_hash`        char(64) NOT NULL,
+    `replaced`            datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    INDEX                 `edit_id` (`edit_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `training_data_text`;
+CREATE TABLE `training_data_text`
+(
+    `hash`                char(64) NOT NULL,
+    `content`             longblob NOT NULL,
+    PRIMARY KEY (`hash`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `qualification_classification`;
+CREATE TABLE `qualification_classification`
+(
+    `id`             int NOT NULL AUTO_INCREMENT,
+    `user_id`        int NOT NULL,
+    `edit_id`        int NOT NULL,
+    `classification` int NOT NULL,
+    `created`        datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `user_edit` (`user_id`, `edit_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `qualification_edit`;
+CREATE TABLE `qualification_edit`
+(
+    `user_id`        int NOT NULL,
+    `edit_id`        int NOT NULL,
+    `position`       int NOT NULL,
+    `classification` int NOT NULL,
+    PRIMARY KEY (`user_id`, `edit_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `user_qualification`;
+CREATE TABLE `user_qualification`
+(
+    `user_id`   int NOT NULL,
+    `total`     int NOT NULL,
+    `correct`   int NOT NULL,
+    `score`     float NOT NULL,
+    `outcome`   int NOT NULL,
+    `completed` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`user_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `edit_label_change`;
+CREATE TABLE `edit_label_change`
+(
+    `id`                 bigint NOT NULL AUTO_INCREMENT,
+    `edit_id`            int NOT NULL,
+    `old_classification` int NOT NULL,
+    `new_classification` int NOT NULL,
+    `created`            datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    INDEX                `edit_id` (`edit_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `dataset_snapshot`;
+CREATE TABLE `dataset_snapshot`
+(
+    `id`          int NOT NULL AUTO_INCREMENT,
+    `name`        varchar(255) NOT NULL,
+    `description` text NOT NULL,
+    `status`      int NOT NULL DEFAULT 0,
+    `entries`     int NOT NULL DEFAULT 0,
+    `created`     datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `published`   datetime NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `name` (`name`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `dataset_snapshot_entry`;
+CREATE TABLE `dataset_snapshot_entry`
+(
+    `snapshot_id`    int NOT NULL,
+    `edit_group_id`  int NOT NULL,
+    `edit_id`        int NOT NULL,
+    `classification` int NOT NULL,
+    `confidence`     float NOT NULL,
+    `vandalism`      int NOT NULL,
+    `constructive`   int NOT NULL,
+    `skipped`        int NOT NULL,
+    PRIMARY KEY (`snapshot_id`, `edit_group_id`, `edit_id`),
+    INDEX            `edit_id` (`edit_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `dataset_snapshot_file`;
+CREATE TABLE `dataset_snapshot_file`
+(
+    `snapshot_id` int NOT NULL,
+    `name`        varchar(255) NOT NULL,
+    `sha256`      char(64) NOT NULL,
+    `size`        bigint NOT NULL,
+    `content`     longblob NOT NULL,
+    PRIMARY KEY (`snapshot_id`, `name`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `data_version`;
+CREATE TABLE `data_version`
+(
+    `id`      int NOT NULL,
+    `version` bigint NOT NULL,
+    `updated` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `training_data_failure`;
+CREATE TABLE `training_data_failure`
+(
+    `edit_id`     int NOT NULL,
+    `attempts`    int NOT NULL,
+    `last_status` int NOT NULL,
+    `last_error`  text NOT NULL,
+    `next_retry`  datetime NOT NULL,
+    `updated`     datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`edit_id`),
+    INDEX         `next_retry` (`next_retry`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `edit_unavailable`;
+CREATE TABLE `edit_unavailable`
+(
+    `edit_id`     int NOT NULL,
+    `reason`      varchar(255) NOT NULL,
+    `detected`    datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `checked`     datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
     PRIMARY KEY (`edit_id`)
 ) ENGINE = InnoDB
   DEFAULT CHARSET = utf8mb4
   COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `edit_group_split`;
+CREATE TABLE `edit_group_split`
+(
+    `edit_group_id` int NOT NULL,
+    `name`          varchar(255) NOT NULL,
+    `split`         varchar(255) NOT NULL,
+    PRIMARY KEY (`edit_group_id`),
+    UNIQUE KEY      `name_split` (`name`, `split`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `report_import_run`;
+CREATE TABLE `report_import_run`
+(
+    `id`          int NOT NULL AUTO_INCREMENT,
+    `started`     datetime NOT NULL,
+    `finished`    datetime NOT NULL,
+    `dry_run`     tinyint(1) NOT NULL,
+    `source`      varchar(255) NOT NULL,
+    `edit_group`  varchar(255) NOT NULL,
+    `new`         int NOT NULL,
+    `existing`    int NOT NULL,
+    `invalid`     int NOT NULL,
+    `error`       text NOT NULL,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `webhook`;
+CREATE TABLE `webhook`
+(
+    `id`      int NOT NULL AUTO_INCREMENT,
+    `url`     varchar(1024) NOT NULL,
+    `secret`  varchar(255) NOT NULL,
+    `events`  varchar(1024) NOT NULL,
+    `created` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `webhook_delivery`;
+CREATE TABLE `webhook_delivery`
+(
+    `id`            int NOT NULL AUTO_INCREMENT,
+    `webhook_id`    int NOT NULL,
+    `event`         varchar(64) NOT NULL,
+    `payload`       mediumtext NOT NULL,
+    `status`        int NOT NULL DEFAULT 0,
+    `attempts`      int NOT NULL DEFAULT 0,
+    `next_attempt`  datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `last_attempt`  datetime NULL,
+    `response_code` int NOT NULL DEFAULT 0,
+    `error`         varchar(1024) NOT NULL DEFAULT '',
+    `created`       datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    INDEX           `webhook_id` (`webhook_id`),
+    INDEX           `status_next_attempt` (`status`, `next_attempt`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
+
+DROP TABLE IF EXISTS `jobs`;
+CREATE TABLE `jobs`
+(
+    `id`          int NOT NULL AUTO_INCREMENT,
+    `name`        varchar(64) NOT NULL,
+    `trigger`     varchar(16) NOT NULL,
+    `status`      int NOT NULL,
+    `error`       text NOT NULL,
+    `started`     datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `finished`    datetime NULL,
+    `duration_ms` bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (`id`),
+    INDEX         `name` (`name`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin;
@@ -1,3 +1,14 @@
+let indexUrl = null;
+
+function isPractice() {
+    return document.body.dataset.practice === "1";
+}
+
+function getComment() {
+    let comment = document.getElementById("comment");
+    return comment ? comment.value : "";
+}
+
 function refreshRender() {
     let editId = document.getElementById("editid").innerText;
     console.log("Refreshing type for " + editId);
@@ -19,8 +30,20 @@ function classifyEdit(classification, confirmation) {
             return;
         }
 
+        // Qualification finished - nothing more to review here
+        let response = JSON.parse(this.responseText);
+        if (response["completed"]) {
+            if (response["approved"]) {
+                alert("Thank you, you have been approved as a reviewer.");
+            } else {
+                alert("Thank you, an admin will review your results.");
+            }
+            window.location.href = "/";
+            return;
+        }
+
         // API wants to confirm - ask the user
-        let require_confirmation = JSON.parse(this.responseText)["require_confirmation"];
+        let require_confirmation = response["require_confirmation"];
         if (require_confirmation) {
             let confirmation = confirm("Are you sure?");
             if (!confirmation) {
@@ -32,10 +55,10 @@ function classifyEdit(classification, confirmation) {
         // We are done - onto the next
         loadNextEditId();
     }
-    req.open("POST", "/api/user-classification", true);
+    req.open("POST", isPractice() ? "/api/qualification/classification" : "/api/user-classification", true);
     req.send(JSON.stringify({
         "edit_id": parseInt(editId),
-        "comment": document.getElementById("comment").value,
+        "comment": getComment(),
         "classification": classification,
         "confirmation": confirmation,
     }));
@@ -50,18 +73,23 @@ function renderEdit(editId) {
     });
     console.log("Rendering: " + editId + " using " + urlType);
 
-    let url = "https://en.wikipedia.org/w/index.php?action=view&diff=" + editId;
+    let url = indexUrl + "?action=view&diff=" + editId;
     if (urlType === "d") {
-        url = "https://en.wikipedia.org/w/index.php?action=view&diffonly=1&diff=" + editId;
+        url = indexUrl + "?action=view&diffonly=1&diff=" + editId;
     } else if (urlType === "r") {
-        url = "https://en.wikipedia.org/w/index.php?action=render&diffonly=1&diff=" + editId;
+        url = indexUrl + "?action=render&diffonly=1&diff=" + editId;
+    } else if (urlType === "s") {
+        // Rendered from the stored training data, works for deleted revisions and offline
+        url = "/api/edit/" + editId + "/diff";
     }
     document.getElementById("editid").innerText = editId;
     document.getElementById("iframe").setAttribute("src", url);
 }
 
 function loadNextEditId() {
-    document.getElementById("comment").value = "";
+    if (document.getElementById("comment")) {
+        document.getElementById("comment").value = "";
+    }
 
     let req = new XMLHttpRequest();
     req.onreadystatechange = function(){
@@ -77,7 +105,7 @@ function loadNextEditId() {
         let editId = JSON.parse(this.responseText)["edit_id"];
         renderEdit(editId);
     }
-    req.open("GET", "/api/edit/next", true);
+    req.open("GET", isPractice() ? "/api/qualification/next" : "/api/edit/next", true);
     req.send();
 }
 
@@ -86,6 +114,25 @@ function loadDetails() {
     window.open("/admin/details/" + editId, true);
 }
 
+function loadConfig() {
+    let req = new XMLHttpRequest();
+    req.onreadystatechange = function(){
+        if (this.readyState !== 4) {
+            return;
+        }
+
+        if (this.status !== 200) {
+            alert('Failed to retrieve config');
+            return;
+        }
+
+        indexUrl = JSON.parse(this.responseText)["wiki"]["index_url"];
+        loadNextEditId();
+    }
+    req.open("GET", "/api/config", true);
+    req.send();
+}
+
 window.onload = function() {
-    loadNextEditId();
+    loadConfig();
 }
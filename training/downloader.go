@@ -0,0 +1,372 @@
+package training
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cluebotng/reviewng/cfg"
+	"github.com/cluebotng/reviewng/db"
+	"github.com/cluebotng/reviewng/wikipedia"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Progress is a snapshot of the current, or last, download run
+type Progress struct {
+	Running     bool       `json:"running"`
+	Total       int        `json:"total"`
+	Completed   int        `json:"completed"`
+	Failed      int        `json:"failed"`
+	Deferred    int        `json:"deferred"`
+	Unavailable int        `json:"unavailable"`
+	Started     *time.Time `json:"started"`
+	Finished    *time.Time `json:"finished"`
+	LastError   string     `json:"last_error,omitempty"`
+}
+
+// downloadError carries the HTTP status of a failed download, 0 for transport errors
+type downloadError struct {
+	Status     int
+	RetryAfter time.Duration
+	Message    string
+	Err        error
+}
+
+func (e *downloadError) Error() string {
+	if e.Status == 0 {
+		return e.Err.Error()
+	}
+	if e.Message != "" {
+		return fmt.Sprintf("Error returned from API: %d: %s", e.Status, e.Message)
+	}
+	return fmt.Sprintf("Error returned from API: %d", e.Status)
+}
+
+// Retryable is true for errors which are expected to clear up by themselves
+func (e *downloadError) Retryable() bool {
+	return e.Status == 0 || e.Status == http.StatusTooManyRequests || e.Status >= 500
+}
+
+// Downloader fetches training data for reviewed edits in the background
+type Downloader struct {
+	config      cfg.TrainingConfig
+	wikiApiUrls map[string]string
+	defaultWiki string
+	dbh         *db.Db
+	client      *http.Client
+	limiter     *rateLimiter
+
+	mutex    sync.Mutex
+	progress Progress
+}
+
+// NewDownloader creates a downloader, the wikis are used to check for deleted revisions when a download fails
+func NewDownloader(config cfg.TrainingConfig, wikis []cfg.WikiConfig, dbh *db.Db) *Downloader {
+	wikiApiUrls := map[string]string{}
+	for _, wiki := range wikis {
+		wikiApiUrls[wiki.Name] = wiki.ApiUrl
+	}
+	return &Downloader{
+		config:      config,
+		wikiApiUrls: wikiApiUrls,
+		defaultWiki: wikis[0].Name,
+		dbh:         dbh,
+		client:      &http.Client{Timeout: time.Duration(config.Timeout) * time.Second},
+		limiter:     newRateLimiter(config.RequestsPerSecond, config.Burst),
+	}
+}
+
+func (d *Downloader) Progress() Progress {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.progress
+}
+
+func (d *Downloader) updateProgress(fn func(p *Progress)) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	fn(&d.progress)
+}
+
+// Run downloads the given edits (id to vandalism flag), returning false if a run is already in progress
+func (d *Downloader) Run(edits map[int]bool) bool {
+	d.mutex.Lock()
+	if d.progress.Running {
+		d.mutex.Unlock()
+		return false
+	}
+	started := time.Now()
+	d.progress = Progress{Running: true, Total: len(edits), Started: &started}
+	d.mutex.Unlock()
+
+	d.run(edits)
+	return true
+}
+
+func (d *Downloader) run(edits map[int]bool) {
+	defer d.updateProgress(func(p *Progress) {
+		finished := time.Now()
+		p.Running = false
+		p.Finished = &finished
+	})
+
+	failures, err := d.dbh.FetchAllTrainingDataFailures()
+	if err != nil {
+		log.Printf("Failed to load training data failures: %+v", err)
+		d.updateProgress(func(p *Progress) { p.LastError = err.Error() })
+		return
+	}
+
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for i := 0; i < d.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for editId := range jobs {
+				d.process(editId, edits[editId], failures[editId])
+			}
+		}()
+	}
+
+	now := time.Now()
+	for editId := range edits {
+		// Edits which failed recently are left until their retry time
+		if failure, ok := failures[editId]; ok && failure.NextRetry.After(now) {
+			d.updateProgress(func(p *Progress) { p.Deferred++ })
+			continue
+		}
+		jobs <- editId
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+func (d *Downloader) process(editId int, isVandalism bool, failure *db.TrainingDataFailure) {
+	trainingData, source, err := d.downloadWithRetries(editId)
+	if downloadErr, ok := err.(*downloadError); ok && !downloadErr.Retryable() && d.markIfUnavailable(editId) {
+		return
+	}
+
+	if err == nil {
+		trainingData.IsVandalism = isVandalism
+		provenance := &db.TrainingDataProvenance{Fetched: time.Now(), Source: source, SchemaVersion: d.config.SchemaVersion}
+		// Invalid data is stored quarantined (unless usable data is already stored), but kept as a failure so it is retried later
+		status, reason := db.TrainingDataStatus(trainingData.Validate())
+		if status == db.TRAINING_DATA_INVALID && d.markIfUnavailable(editId) {
+			return
+		}
+		var stored bool
+		if stored, err = d.dbh.StoreTrainingDataForEdit(editId, trainingData, provenance); err == nil && status == db.TRAINING_DATA_INVALID {
+			if !stored {
+				log.Printf("Kept existing training data for %v, the refreshed data is invalid", editId)
+			}
+			err = fmt.Errorf("invalid training data: %s", reason)
+		} else if err == nil {
+			if failure != nil {
+				if err := d.dbh.DeleteTrainingDataFailure(editId); err != nil {
+					log.Printf("Failed to clear training data failure: %v: %+v", editId, err)
+				}
+			}
+			d.updateProgress(func(p *Progress) { p.Completed++ })
+			log.Printf("Saved training data: %v (%+v)", editId, isVandalism)
+			return
+		}
+	}
+
+	log.Printf("Failed to download training data: %v: %+v", editId, err)
+	d.updateProgress(func(p *Progress) {
+		p.Failed++
+		p.LastError = fmt.Sprintf("%d: %v", editId, err)
+	})
+
+	// Later runs back off exponentially on the attempts so far
+	attempts, status := 1, 0
+	if failure != nil {
+		attempts = failure.Attempts + 1
+	}
+	if downloadErr, ok := err.(*downloadError); ok {
+		status = downloadErr.Status
+	}
+	nextRetry := time.Now().Add(d.backoff(attempts, time.Minute))
+	if err := d.dbh.RecordTrainingDataFailure(editId, status, err.Error(), nextRetry); err != nil {
+		log.Printf("Failed to record training data failure: %v: %+v", editId, err)
+	}
+}
+
+// lookupRevisionDeletion checks the revision on the wiki of the edit's group, edits without a group use the default wiki
+func (d *Downloader) lookupRevisionDeletion(editId int) (string, error) {
+	wiki, err := d.dbh.LookupEditWiki(editId)
+	if err != nil {
+		return "", err
+	}
+	if wiki == "" {
+		wiki = d.defaultWiki
+	}
+
+	apiUrl, ok := d.wikiApiUrls[wiki]
+	if !ok {
+		return "", fmt.Errorf("wiki is not configured: %s", wiki)
+	}
+	return wikipedia.LookupRevisionDeletion(d.client, apiUrl, editId)
+}
+
+// markIfUnavailable checks the wiki after a failed download, recording deleted revisions so they are no longer retried
+func (d *Downloader) markIfUnavailable(editId int) bool {
+	reason, err := d.lookupRevisionDeletion(editId)
+	if err != nil {
+		log.Printf("Failed to check revision: %v: %+v", editId, err)
+		return false
+	}
+	if reason == "" {
+		return false
+	}
+
+	if err := d.dbh.MarkEditUnavailable(editId, reason); err != nil {
+		log.Printf("Failed to mark edit unavailable: %v: %+v", editId, err)
+		return false
+	}
+	if err := d.dbh.DeleteTrainingDataFailure(editId); err != nil {
+		log.Printf("Failed to clear training data failure: %v: %+v", editId, err)
+	}
+
+	d.updateProgress(func(p *Progress) { p.Unavailable++ })
+	log.Printf("Edit is unavailable: %v (%s)", editId, reason)
+	return true
+}
+
+// Recheck looks the edit up on the wiki again, returning it to the queue if it has been restored
+func (d *Downloader) Recheck(editId int) (string, error) {
+	reason, err := d.lookupRevisionDeletion(editId)
+	if err != nil {
+		return "", err
+	}
+
+	if reason != "" {
+		return reason, d.dbh.MarkEditUnavailable(editId, reason)
+	}
+
+	if _, err := d.dbh.MarkEditAvailable(editId); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+// Fetch downloads the training data for an edit without storing it, for edits which have not been reviewed yet
+func (d *Downloader) Fetch(editId int) (*db.TrainingData, error) {
+	trainingData, _, err := d.download(editId)
+	return trainingData, err
+}
+
+// backoff doubles base for each attempt, capped at the configured maximum
+func (d *Downloader) backoff(attempts int, base time.Duration) time.Duration {
+	maxBackoff := time.Duration(d.config.MaxBackoff) * time.Second
+	backoff := time.Duration(float64(base) * math.Pow(2, float64(attempts-1)))
+	if backoff <= 0 || backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+func (d *Downloader) downloadWithRetries(editId int) (*db.TrainingData, string, error) {
+	for attempt := 1; ; attempt++ {
+		trainingData, source, err := d.download(editId)
+		if err == nil {
+			return trainingData, source, nil
+		}
+
+		downloadErr, ok := err.(*downloadError)
+		if !ok || !downloadErr.Retryable() || attempt > d.config.Retries {
+			return nil, "", err
+		}
+
+		// Prefer the server's hint when rate limited
+		delay := d.backoff(attempt, time.Second)
+		if downloadErr.RetryAfter > 0 {
+			delay = downloadErr.RetryAfter
+		}
+		time.Sleep(delay)
+	}
+}
+
+// download fetches the training data for an edit, returning it with the url it was fetched from
+func (d *Downloader) download(editId int) (*db.TrainingData, string, error) {
+	if err := d.limiter.Wait(context.Background()); err != nil {
+		return nil, "", err
+	}
+
+	query := url.Values{}
+	query.Set("action", "training.data")
+	query.Set("include_text", "1")
+	query.Set("rev_id", strconv.Itoa(editId))
+	source := d.config.ApiUrl + "?" + query.Encode()
+	req, err := http.NewRequest("GET", source, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("User-Agent", "ClueBot NG Review NG/1.0")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, "", &downloadError{Err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		downloadErr := &downloadError{Status: resp.StatusCode}
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			downloadErr.RetryAfter = time.Duration(seconds) * time.Second
+		}
+		return nil, "", downloadErr
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", &downloadError{Err: err}
+	}
+
+	// Errors, such as for deleted revisions, are returned in the body
+	apiError := struct {
+		Error interface{} `json:"error"`
+	}{}
+	if err := json.Unmarshal(body, &apiError); err != nil {
+		return nil, "", err
+	}
+	if apiError.Error != nil {
+		return nil, "", &downloadError{Status: resp.StatusCode, Message: fmt.Sprint(apiError.Error)}
+	}
+
+	trainingData := &db.TrainingData{}
+	if err := json.Unmarshal(body, trainingData); err != nil {
+		return nil, "", err
+	}
+	return trainingData, source, nil
+}
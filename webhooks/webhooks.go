@@ -0,0 +1,118 @@
+package webhooks
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	EventEditConsensus      = "edit.consensus"
+	EventEditContested      = "edit.contested"
+	EventEditLabelChanged   = "edit.label_changed"
+	EventUserSignup         = "user.signup"
+	EventEditGroupCompleted = "edit_group.completed"
+	EventPing               = "ping"
+)
+
+// Events are those which can be subscribed to, ping is only sent when testing a webhook
+var Events = []string{
+	EventEditConsensus,
+	EventEditContested,
+	EventEditLabelChanged,
+	EventUserSignup,
+	EventEditGroupCompleted,
+}
+
+// Sign returns the signature header value, a HMAC-SHA256 of the timestamp and body joined by a dot
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff is the delay before the next attempt, doubling from 30 seconds up to max
+func Backoff(attempts int, max time.Duration) time.Duration {
+	backoff := 30 * time.Second
+	for i := 1; i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
+
+// Sender posts signed deliveries
+type Sender struct {
+	client *http.Client
+}
+
+func NewSender(timeout time.Duration) *Sender {
+	return &Sender{client: &http.Client{Timeout: timeout}}
+}
+
+// Send posts the body, returning the response status (0 if there was none) and an error unless it was 2xx
+func (s *Sender) Send(url, secret, event string, deliveryId int, body []byte) (int, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ClueBot NG Review NG/1.0")
+	req.Header.Set("X-Review-Event", event)
+	req.Header.Set("X-Review-Delivery", strconv.Itoa(deliveryId))
+	req.Header.Set("X-Review-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Review-Signature", Sign(secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	// The response is not used, but is read so the connection can be re-used
+	if _, err := io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64*1024)); err != nil {
+		return resp.StatusCode, err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return resp.StatusCode, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response: %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,115 @@
+package webhooks
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSendSignsDelivery(t *testing.T) {
+	secret, body := "secret", []byte(`{"event":"ping"}`)
+
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		received, receivedBody = r, data
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	status, err := NewSender(time.Second).Send(server.URL, secret, EventPing, 42, body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", status, http.StatusNoContent)
+	}
+	if received == nil {
+		t.Fatal("delivery was not received")
+	}
+
+	if string(receivedBody) != string(body) {
+		t.Errorf("body = %s, want %s", receivedBody, body)
+	}
+	if got := received.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+	if got := received.Header.Get("X-Review-Event"); got != EventPing {
+		t.Errorf("X-Review-Event = %q, want %q", got, EventPing)
+	}
+	if got := received.Header.Get("X-Review-Delivery"); got != "42" {
+		t.Errorf("X-Review-Delivery = %q, want 42", got)
+	}
+
+	timestamp, err := strconv.ParseInt(received.Header.Get("X-Review-Timestamp"), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid X-Review-Timestamp: %v", err)
+	}
+	if want := Sign(secret, timestamp, receivedBody); received.Header.Get("X-Review-Signature") != want {
+		t.Errorf("X-Review-Signature = %q, want %q", received.Header.Get("X-Review-Signature"), want)
+	}
+	if received.Header.Get("X-Review-Signature") == Sign("other", timestamp, receivedBody) {
+		t.Error("signature does not depend on the secret")
+	}
+}
+
+func TestSendReturnsErrorForNon2xx(t *testing.T) {
+	for _, code := range []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError, http.StatusServiceUnavailable} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(code)
+		}))
+
+		status, err := NewSender(time.Second).Send(server.URL, "secret", EventPing, 1, []byte("{}"))
+		server.Close()
+
+		if err == nil {
+			t.Errorf("%d: expected an error so the delivery is retried", code)
+		}
+		if status != code {
+			t.Errorf("status = %d, want %d", status, code)
+		}
+	}
+}
+
+func TestSendReturnsErrorWithoutResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	status, err := NewSender(time.Second).Send(url, "secret", EventPing, 1, []byte("{}"))
+	if err == nil {
+		t.Error("expected an error when the receiver is unreachable")
+	}
+	if status != 0 {
+		t.Errorf("status = %d, want 0", status)
+	}
+}
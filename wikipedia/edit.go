@@ -32,8 +32,8 @@ import (
 	"strings"
 )
 
-func getLoginToken(httpClient *http.Client) (string, error) {
-	req, err := http.NewRequest("GET", "https://en.wikipedia.org/w/api.php?action=query&meta=tokens&type=login&format=json", nil)
+func getLoginToken(httpClient *http.Client, apiUrl string) (string, error) {
+	req, err := http.NewRequest("GET", apiUrl+"?action=query&meta=tokens&type=login&format=json", nil)
 	if err != nil {
 		return "", nil
 	}
@@ -64,8 +64,8 @@ func getLoginToken(httpClient *http.Client) (string, error) {
 	return data.Query.Tokens.LoginToken, nil
 }
 
-func getCsrfToken(httpClient *http.Client) (string, error) {
-	req, err := http.NewRequest("GET", "https://en.wikipedia.org/w/api.php?action=query&meta=tokens&format=json", nil)
+func getCsrfToken(httpClient *http.Client, apiUrl string) (string, error) {
+	req, err := http.NewRequest("GET", apiUrl+"?action=query&meta=tokens&format=json", nil)
 	if err != nil {
 		return "", nil
 	}
@@ -96,7 +96,7 @@ func getCsrfToken(httpClient *http.Client) (string, error) {
 	return data.Query.Tokens.CsrfToken, nil
 }
 
-func login(httpClient *http.Client, username, password, token string) error {
+func login(httpClient *http.Client, apiUrl, username, password, token string) error {
 	form := url.Values{}
 	form.Add("action", "login")
 	form.Add("lgname", username)
@@ -104,7 +104,7 @@ func login(httpClient *http.Client, username, password, token string) error {
 	form.Add("lgtoken", token)
 	form.Add("format", "json")
 
-	req, err := http.NewRequest("POST", "https://en.wikipedia.org/w/api.php", strings.NewReader(form.Encode()))
+	req, err := http.NewRequest("POST", apiUrl, strings.NewReader(form.Encode()))
 	if err != nil {
 		return err
 	}
@@ -137,12 +137,12 @@ func login(httpClient *http.Client, username, password, token string) error {
 	return nil
 }
 
-func UpdatePage(contents string) error {
+func UpdatePage(apiUrl, title, contents string) error {
 	httpClient := &http.Client{}
-	return updatePage(httpClient, contents)
+	return updatePage(httpClient, apiUrl, title, contents)
 }
 
-func UpdatePageWithCredentials(contents, username, password string) error {
+func UpdatePageWithCredentials(apiUrl, title, contents, username, password string) error {
 	jar, err := cookiejar.New(nil)
 	if err != nil {
 		return err
@@ -150,32 +150,32 @@ func UpdatePageWithCredentials(contents, username, password string) error {
 
 	httpClient := &http.Client{Jar: jar}
 
-	loginToken, err := getLoginToken(httpClient)
+	loginToken, err := getLoginToken(httpClient, apiUrl)
 	if err != nil {
 		panic(err)
 	}
 
-	if err := login(httpClient, username, password, loginToken); err != nil {
+	if err := login(httpClient, apiUrl, username, password, loginToken); err != nil {
 		panic(err)
 	}
 
-	return updatePage(httpClient, contents)
+	return updatePage(httpClient, apiUrl, title, contents)
 }
 
-func updatePage(httpClient *http.Client, contents string) error {
-	csrfToken, err := getCsrfToken(httpClient)
+func updatePage(httpClient *http.Client, apiUrl, title, contents string) error {
+	csrfToken, err := getCsrfToken(httpClient, apiUrl)
 	if err != nil {
 		return nil
 	}
 	form := url.Values{}
 	form.Add("action", "edit")
-	form.Add("title", "User:ClueBot NG/ReviewInterface/Stats")
+	form.Add("title", title)
 	form.Add("summary", "Uploading Stats")
 	form.Add("token", csrfToken)
 	form.Add("format", "json")
 	form.Add("text", contents)
 
-	req, err := http.NewRequest("POST", "https://en.wikipedia.org/w/api.php", strings.NewReader(form.Encode()))
+	req, err := http.NewRequest("POST", apiUrl, strings.NewReader(form.Encode()))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,106 @@
+package wikipedia
+
+// MIT License
+//
+// Copyright (c) 2021 Damian Zaremba
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// LookupRevisionDeletion returns why a revision can't be viewed, or an empty string when it is visible
+func LookupRevisionDeletion(httpClient *http.Client, apiUrl string, revId int) (string, error) {
+	query := url.Values{}
+	query.Set("action", "query")
+	query.Set("prop", "revisions")
+	query.Set("revids", strconv.Itoa(revId))
+	query.Set("rvprop", "ids|flags")
+	query.Set("format", "json")
+	query.Set("formatversion", "2")
+
+	req, err := http.NewRequest("GET", apiUrl+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", "ClueBot NG Review NG/1.0")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("API error: %d", resp.StatusCode)
+	}
+
+	data := struct {
+		Query struct {
+			BadRevIds map[string]interface{} `json:"badrevids"`
+			Pages     []struct {
+				Missing   bool `json:"missing"`
+				Revisions []struct {
+					RevId      int  `json:"revid"`
+					TextHidden bool `json:"texthidden"`
+					Suppressed bool `json:"suppressed"`
+				} `json:"revisions"`
+			} `json:"pages"`
+		} `json:"query"`
+	}{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return "", err
+	}
+
+	// Revisions of deleted pages are reported as bad
+	if _, ok := data.Query.BadRevIds[strconv.Itoa(revId)]; ok {
+		return "deleted", nil
+	}
+
+	for _, page := range data.Query.Pages {
+		if page.Missing {
+			return "page deleted", nil
+		}
+		for _, revision := range page.Revisions {
+			if revision.RevId != revId {
+				continue
+			}
+			if revision.Suppressed {
+				return "suppressed", nil
+			}
+			if revision.TextHidden {
+				return "text hidden", nil
+			}
+			return "", nil
+		}
+	}
+	return "", fmt.Errorf("API error: revision %d not returned", revId)
+}